@@ -0,0 +1,183 @@
+package auditlogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	auditLogsPath = "/api/v9/audit_logs/organizations/%d/from/%s/to/%s"
+
+	defaultPageSize = 100
+)
+
+// EntityType represents the kind of entity an audit log record is about.
+type EntityType string
+
+const (
+	EntityTypeClient        EntityType = "client"
+	EntityTypeGroup         EntityType = "group"
+	EntityTypeProject       EntityType = "project"
+	EntityTypeTag           EntityType = "tag"
+	EntityTypeTask          EntityType = "task"
+	EntityTypeTimeEntry     EntityType = "time_entry"
+	EntityTypeWorkspace     EntityType = "workspace"
+	EntityTypeWorkspaceUser EntityType = "workspace_user"
+)
+
+// Action represents the change recorded by an audit log record.
+type Action string
+
+const (
+	ActionCreated Action = "created"
+	ActionUpdated Action = "updated"
+	ActionDeleted Action = "deleted"
+)
+
+// GetAuditLogsQuery represents the query parameters for fetching audit logs.
+type GetAuditLogsQuery struct {
+	WorkspaceId *int        // Only records of this workspace
+	EntityType  *EntityType // Only records about this kind of entity
+	EntityId    *int        // Only records about this entity, to be used with EntityType
+	Action      *Action     // Only records of this action
+	UserId      *int        // Only records of changes made by this user
+	PageSize    *int        // Number of records per page
+	PageNumber  *int        // Page number, starting at 1
+	Export      bool        // Lift the limits of the API for exports
+}
+
+// GetAuditLogsInput contains the input data for GetAuditLogs.
+type GetAuditLogsInput struct {
+	OrganizationId int    // required
+	From           string // required, start of the time window in RFC3339 format
+	To             string // required, end of the time window in RFC3339 format
+	Query          GetAuditLogsQuery
+}
+
+// GetAuditLogsOutput represents a change made to an entity.
+type GetAuditLogsOutput struct {
+	Action         Action          `json:"action"`
+	Changes        json.RawMessage `json:"changes"` // Changed fields, their shape depends on the entity type
+	CreatedAt      string          `json:"created_at"`
+	EntityId       int             `json:"entity_id"`
+	EntityType     EntityType      `json:"entity_type"`
+	OrganizationId int             `json:"organization_id"`
+	UserId         int             `json:"user_id"`
+	UserName       string          `json:"user_name"`
+	WorkspaceId    int             `json:"workspace_id"`
+}
+
+// TimeEntryId returns the ID of the time entry the record is about, as used by the timeentries package.
+// It reports false if the record is about another kind of entity.
+func (a GetAuditLogsOutput) TimeEntryId() (int, bool) {
+	if a.EntityType != EntityTypeTimeEntry {
+		return 0, false
+	}
+	return a.EntityId, true
+}
+
+// GetAuditLogs retrieves one page of the audit logs of an organization in a time window.
+func (c Client) GetAuditLogs(input GetAuditLogsInput) ([]GetAuditLogsOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return nil, ErrorRequiredParameter
+	}
+	if input.From == "" {
+		slog.Error("From is required")
+		return nil, ErrorRequiredParameter
+	}
+	if input.To == "" {
+		slog.Error("To is required")
+		return nil, ErrorRequiredParameter
+	}
+	galq := input.Query
+	q := url.Values{}
+	if galq.WorkspaceId != nil {
+		q.Add("workspace_id", fmt.Sprintf("%v", *galq.WorkspaceId))
+	}
+	if galq.EntityType != nil {
+		q.Add("entity_type", string(*galq.EntityType))
+	}
+	if galq.EntityId != nil {
+		q.Add("entity_id", fmt.Sprintf("%v", *galq.EntityId))
+	}
+	if galq.Action != nil {
+		q.Add("action", string(*galq.Action))
+	}
+	if galq.UserId != nil {
+		q.Add("user_id", fmt.Sprintf("%v", *galq.UserId))
+	}
+	if galq.PageSize != nil {
+		q.Add("page_size", fmt.Sprintf("%v", *galq.PageSize))
+	}
+	if galq.PageNumber != nil {
+		q.Add("page_number", fmt.Sprintf("%v", *galq.PageNumber))
+	}
+	if galq.Export {
+		q.Add("export", fmt.Sprintf("%v", galq.Export))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(auditLogsPath, input.OrganizationId, input.From, input.To)
+	toggl.URL.RawPath = fmt.Sprintf(auditLogsPath, input.OrganizationId, url.PathEscape(input.From), url.PathEscape(input.To))
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetAuditLogsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	galo := make([]GetAuditLogsOutput, 0)
+	if err := json.Unmarshal(body, &galo); err != nil {
+		return nil, err
+	}
+
+	return galo, nil
+}
+
+// EachAuditLog calls f for every audit log record matching the input, requesting one page at a time
+// from Query.PageNumber on so that large exports are not held in memory.
+// It stops at the first error returned by the API or by f.
+func (c Client) EachAuditLog(input GetAuditLogsInput, f func(GetAuditLogsOutput) error) error {
+	pageSize := defaultPageSize
+	if input.Query.PageSize != nil {
+		pageSize = *input.Query.PageSize
+	}
+	pageNumber := 1
+	if input.Query.PageNumber != nil {
+		pageNumber = *input.Query.PageNumber
+	}
+	for {
+		input.Query.PageSize = &pageSize
+		input.Query.PageNumber = &pageNumber
+		page, err := c.GetAuditLogs(input)
+		if err != nil {
+			return err
+		}
+		for _, record := range page {
+			if err := f(record); err != nil {
+				return err
+			}
+		}
+		if len(page) == 0 || len(page) < pageSize {
+			return nil
+		}
+		pageNumber++
+	}
+}
@@ -0,0 +1,159 @@
+package auditlogs_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/auditlogs"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) auditlogs.Client {
+	return auditlogs.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetAuditLogs(t *testing.T) {
+	testFile := readTestFile(t, "testdata/auditlogs/audit_logs.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	from := "2024-01-01T00:00:00Z"
+	to := "2024-02-01T00:00:00Z"
+	test := []struct {
+		name     string
+		client   auditlogs.Client
+		arg      auditlogs.GetAuditLogsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, auditlogs.GetAuditLogsInput{OrganizationId: organizationId, From: from, To: to}, testFile, nil},
+		{"OrganizationId parameter error", successClient, auditlogs.GetAuditLogsInput{From: from, To: to}, errorWant, auditlogs.ErrorRequiredParameter},
+		{"From parameter error", successClient, auditlogs.GetAuditLogsInput{OrganizationId: organizationId, To: to}, errorWant, auditlogs.ErrorRequiredParameter},
+		{"To parameter error", successClient, auditlogs.GetAuditLogsInput{OrganizationId: organizationId, From: from}, errorWant, auditlogs.ErrorRequiredParameter},
+		{"http error", errorClient, auditlogs.GetAuditLogsInput{OrganizationId: organizationId, From: from, To: to}, errorWant, auditlogs.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetAuditLogs(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestEachAuditLog(t *testing.T) {
+	pages := []string{
+		`[{"entity_id":1,"entity_type":"time_entry"},{"entity_id":2,"entity_type":"project"}]`,
+		`[{"entity_id":3,"entity_type":"time_entry"}]`,
+	}
+	requests := make([]string, 0)
+	client := auditlogs.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				requests = append(requests, r.URL.RawQuery)
+				page := pages[len(requests)-1]
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(page))}, nil
+			},
+		},
+	}
+
+	pageSize := 2
+	timeEntryIds := make([]int, 0)
+	err := client.EachAuditLog(auditlogs.GetAuditLogsInput{
+		OrganizationId: 123456789,
+		From:           "2024-01-01T00:00:00Z",
+		To:             "2024-02-01T00:00:00Z",
+		Query:          auditlogs.GetAuditLogsQuery{PageSize: &pageSize},
+	}, func(record auditlogs.GetAuditLogsOutput) error {
+		if id, ok := record.TimeEntryId(); ok {
+			timeEntryIds = append(timeEntryIds, id)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"page_number=1&page_size=2", "page_number=2&page_size=2"}; !cmp.Equal(want, requests) {
+		t.Errorf("diff: %v", cmp.Diff(want, requests))
+	}
+	if want := []int{1, 3}; !cmp.Equal(want, timeEntryIds) {
+		t.Errorf("diff: %v", cmp.Diff(want, timeEntryIds))
+	}
+}
+
+func TestGetAuditLogsEscape(t *testing.T) {
+	var got string
+	client := auditlogs.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				got = r.URL.EscapedPath()
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(`[]`))}, nil
+			},
+		},
+	}
+	if _, err := client.GetAuditLogs(auditlogs.GetAuditLogsInput{OrganizationId: 1, From: "2024-01-01T00:00:00+09:00", To: "2024/02 01"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/api/v9/audit_logs/organizations/1/from/2024-01-01T00:00:00+09:00/to/2024%2F02%2001"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
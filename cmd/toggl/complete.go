@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// completionTTL is how long fetched completion candidates are cached.
+const completionTTL = 10 * time.Minute
+
+// maxDescriptions is the number of recent descriptions offered for completion.
+const maxDescriptions = 50
+
+// completionCache holds the candidates fetched from Toggl by profile, kind and workspace.
+type completionCache map[string]cachedCandidates
+
+type cachedCandidates struct {
+	Fetched time.Time `json:"fetched"`
+	Values  []string  `json:"values"`
+}
+
+func setupComplete(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		values, err := completionCandidates(e, args[0])
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			fmt.Fprintln(e.stdout, v)
+		}
+		return nil
+	}
+}
+
+// completionCandidates returns the candidates of the kind of completion.
+func completionCandidates(e *env, kind string) ([]string, error) {
+	switch kind {
+	case "commands":
+		names := []string{}
+		for _, cmd := range visibleCommands() {
+			names = append(names, cmd.name)
+		}
+		return names, nil
+	case "profiles":
+		c, err := e.loadConfig()
+		if err != nil {
+			return nil, err
+		}
+		return slices.Sorted(maps.Keys(c.Profiles)), nil
+	case "projects", "tags", "descriptions":
+		workspaceId, err := e.workspaceId(0)
+		if err != nil {
+			return nil, err
+		}
+		values, err := cachedCompletion(e, kind, workspaceId)
+		if err != nil {
+			return nil, err
+		}
+		if kind == "projects" && e.profile != nil {
+			values = append(slices.Sorted(maps.Keys(e.profile.Aliases)), values...)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("unknown completion %q", kind)
+}
+
+// completionCachePath returns the path of the completion cache: toggl/completion.json in
+// $XDG_CACHE_HOME or ~/.cache. It returns an empty string if there is no home directory.
+func completionCachePath(getenv func(string) string) string {
+	dir := getenv("XDG_CACHE_HOME")
+	if dir == "" {
+		home := getenv("HOME")
+		if home == "" {
+			return ""
+		}
+		dir = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(dir, "toggl", "completion.json")
+}
+
+// cachedCompletion returns the candidates of the kind in the workspace, fetching them
+// if they are not cached or older than completionTTL. A broken cache is refetched.
+func cachedCompletion(e *env, kind string, workspaceId int) ([]string, error) {
+	path := completionCachePath(e.getenv)
+	key := e.profileName + "/" + kind + "/" + strconv.Itoa(workspaceId)
+	cache := completionCache{}
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if err == nil && json.Unmarshal(b, &cache) != nil {
+			cache = completionCache{}
+		}
+	}
+	if cached, ok := cache[key]; ok && e.now().Sub(cached.Fetched) < completionTTL {
+		return cached.Values, nil
+	}
+
+	values, err := fetchCompletion(e, kind, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return values, nil
+	}
+	cache[key] = cachedCandidates{Fetched: e.now(), Values: values}
+	b, err := json.Marshal(cache)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeCompletionCache(path, b); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// writeCompletionCache replaces the cache at path with b. It is written to a temporary
+// file first, so that completions running at the same time never read a partial cache.
+func writeCompletionCache(path string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), "completion-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// fetchCompletion fetches the candidates of the kind in the workspace from Toggl.
+func fetchCompletion(e *env, kind string, workspaceId int) ([]string, error) {
+	c, err := e.togglClient()
+	if err != nil {
+		return nil, err
+	}
+	values := []string{}
+	switch kind {
+	case "projects":
+		projects, err := c.MeClient.GetProjects(me.GetProjectsInput{})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			if p.WorkspaceId == workspaceId && p.Active {
+				values = append(values, p.Name)
+			}
+		}
+		slices.Sort(values)
+	case "tags":
+		tags, err := c.MeClient.GetTags(me.GetTagsInput{})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tags {
+			if t.WorkspaceId == workspaceId && t.DeletedAt == nil {
+				values = append(values, t.Name)
+			}
+		}
+		slices.Sort(values)
+	case "descriptions":
+		entries, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{})
+		if err != nil {
+			return nil, err
+		}
+		// Time entries are returned newest first, so are the descriptions.
+		for _, te := range entries {
+			if te.WorkspaceId == workspaceId && te.Description != "" && !slices.Contains(values, te.Description) {
+				values = append(values, te.Description)
+			}
+			if len(values) == maxDescriptions {
+				break
+			}
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,369 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// shells are the shells completion scripts are generated for.
+var shells = []string{"bash", "zsh", "fish"}
+
+// completion describes the candidates of a flag value or of positional arguments.
+type completion struct {
+	words   []string // Static candidates
+	dynamic string   // Kind of the candidates printed by "toggl __complete <kind>"
+}
+
+// flagCompletions are the candidates of flag values by flag name, shared by all commands.
+var flagCompletions = map[string]completion{
+	"profile":     {dynamic: "profiles"},
+	"project":     {dynamic: "projects"},
+	"p":           {dynamic: "projects"},
+	"tags":        {dynamic: "tags"},
+	"t":           {dynamic: "tags"},
+	"tag":         {dynamic: "tags"},
+	"add-tag":     {dynamic: "tags"},
+	"remove-tag":  {dynamic: "tags"},
+	"description": {dynamic: "descriptions"},
+	"d":           {dynamic: "descriptions"},
+	"format":      {words: formats},
+	"o":           {words: formats},
+}
+
+// completionFlag is a flag as seen by completion.
+type completionFlag struct {
+	name   string
+	arg    string // Name of the value, empty for boolean flags
+	usage  string
+	values completion
+}
+
+// completionFlags returns the flags of fs.
+func completionFlags(fs *flag.FlagSet) []completionFlag {
+	var flags []completionFlag
+	fs.VisitAll(func(f *flag.Flag) {
+		arg, usage := flag.UnquoteUsage(f)
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+			arg = ""
+		}
+		flags = append(flags, completionFlag{name: f.Name, arg: arg, usage: usage, values: flagCompletions[f.Name]})
+	})
+	return flags
+}
+
+// commandFlags returns the flags of the command.
+func commandFlags(cmd command) []completionFlag {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	cmd.setup(fs)
+	return completionFlags(fs)
+}
+
+// visibleCommands returns the commands listed in the usage.
+func visibleCommands() []command {
+	return slices.DeleteFunc(slices.Clone(commands), func(cmd command) bool { return cmd.hidden })
+}
+
+func setupCompletion(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		global, _ := newGlobalFlagSet(io.Discard)
+		globals := completionFlags(global)
+		switch args[0] {
+		case "bash":
+			writeBashCompletion(e.stdout, globals)
+		case "zsh":
+			writeZshCompletion(e.stdout, globals)
+		case "fish":
+			writeFishCompletion(e.stdout, globals)
+		default:
+			return fmt.Errorf("unknown shell %q, use one of %s", args[0], strings.Join(shells, ", "))
+		}
+		return nil
+	}
+}
+
+// bashWords quotes the words as one newline separated bash string.
+func bashWords(words []string) string {
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = r.Replace(w)
+	}
+	return "$'" + strings.Join(quoted, `\n`) + "'"
+}
+
+// bashValues returns the call of _toggl_values completing from c.
+func bashValues(c completion) string {
+	return strings.TrimSpace("_toggl_values " + bashWords(c.words) + " " + c.dynamic)
+}
+
+// bashFlags writes the case arms completing the values of the flags, and completing flag
+// names or positional arguments from args otherwise.
+func bashFlags(w io.Writer, indent string, flags []completionFlag, args completion) {
+	fmt.Fprintf(w, "%scase $prev in\n", indent)
+	for _, f := range flags {
+		if f.arg == "" {
+			continue
+		}
+		fmt.Fprintf(w, "%s-%s | --%s)\n", indent, f.name, f.name)
+		if f.values.words == nil && f.values.dynamic == "" {
+			fmt.Fprintf(w, "%s\tCOMPREPLY=()\n", indent)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\n", indent, bashValues(f.values))
+		}
+		fmt.Fprintf(w, "%s\treturn\n%s\t;;\n", indent, indent)
+	}
+	fmt.Fprintf(w, "%sesac\n", indent)
+	names := make([]string, 0, len(flags))
+	for _, f := range flags {
+		names = append(names, "-"+f.name)
+	}
+	fmt.Fprintf(w, "%sif [[ $cur == -* ]]; then\n", indent)
+	fmt.Fprintf(w, "%s\t_toggl_values %s\n", indent, bashWords(names))
+	fmt.Fprintf(w, "%selse\n", indent)
+	fmt.Fprintf(w, "%s\t%s\n", indent, bashValues(args))
+	fmt.Fprintf(w, "%sfi\n", indent)
+}
+
+func writeBashCompletion(w io.Writer, globals []completionFlag) {
+	io.WriteString(w, `# bash completion for toggl, generated by "toggl completion bash".
+# Load it with: source <(toggl completion bash)
+
+# _toggl_values completes the current word from the newline separated words in $1
+# and the candidates of kind $2 printed by "toggl __complete".
+# The candidates come from Toggl, so they are matched literally and never expanded,
+# as compgen -W would do.
+_toggl_values() {
+	local w
+	COMPREPLY=()
+	while IFS= read -r w; do
+		[[ -n $w && $w == "$cur"* ]] && COMPREPLY+=("$(printf '%q' "$w")")
+	done < <(
+		printf '%s\n' "$1"
+		if [[ -n $2 ]]; then
+			toggl ${_toggl_profile:+-profile "$_toggl_profile"} __complete "$2" 2>/dev/null
+		fi
+	)
+}
+
+_toggl() {
+	local cur=${COMP_WORDS[COMP_CWORD]} prev=${COMP_WORDS[COMP_CWORD-1]}
+	local _toggl_profile="" cmd="" i
+	for ((i = 1; i < COMP_CWORD; i++)); do
+		case ${COMP_WORDS[i]} in
+		-profile | --profile)
+			((i++))
+			_toggl_profile=${COMP_WORDS[i]}
+			;;
+		-*) ;;
+		*)
+			cmd=${COMP_WORDS[i]}
+			break
+			;;
+		esac
+	done
+
+	case $cmd in
+	"")
+`)
+	names := make([]string, 0, len(commands))
+	for _, cmd := range visibleCommands() {
+		names = append(names, cmd.name)
+	}
+	bashFlags(w, "\t\t", globals, completion{words: names})
+	fmt.Fprint(w, "\t\t;;\n")
+	for _, cmd := range visibleCommands() {
+		fmt.Fprintf(w, "\t%s)\n", cmd.name)
+		bashFlags(w, "\t\t", commandFlags(cmd), cmd.complete)
+		fmt.Fprint(w, "\t\t;;\n")
+	}
+	io.WriteString(w, `	esac
+}
+
+complete -F _toggl toggl
+`)
+}
+
+// zshQuote escapes s for a single quoted zsh string.
+func zshQuote(s string) string {
+	return strings.ReplaceAll(s, `'`, `'\''`)
+}
+
+// zshSpec returns the _arguments specification of the flag.
+func zshSpec(f completionFlag) string {
+	desc := strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`).Replace(f.usage)
+	spec := fmt.Sprintf("*-%s[%s]", f.name, desc)
+	if f.arg != "" {
+		spec += ":" + strings.ReplaceAll(f.arg, ":", `\:`) + ":" + zshValues(f.values)
+	}
+	return "'" + zshQuote(spec) + "'"
+}
+
+// zshValues returns the action completing from c.
+func zshValues(c completion) string {
+	if c.words == nil && c.dynamic == "" {
+		return " "
+	}
+	return strings.Join(append([]string{"_toggl_values", `"` + c.dynamic + `"`}, c.words...), " ")
+}
+
+func writeZshCompletion(w io.Writer, globals []completionFlag) {
+	io.WriteString(w, `#compdef toggl
+# zsh completion for toggl, generated by "toggl completion zsh".
+# Load it with: source <(toggl completion zsh), or save it as _toggl in a directory of $fpath.
+
+# _toggl_values completes the words after $1 and the candidates of kind $1 printed by "toggl __complete".
+# _call_program evaluates its arguments, so the profile from the command line is quoted.
+# The candidates are added literally by compadd.
+_toggl_values() {
+	local kind=$1 expl
+	shift
+	local -a values
+	values=("$@")
+	if [[ -n $kind ]]; then
+		values+=(${(f)"$(_call_program $kind toggl ${_toggl_profile:+-profile ${(q)_toggl_profile}} __complete $kind 2>/dev/null)"})
+	fi
+	_wanted ${kind:-values} expl ${kind:-value} compadd -a values
+}
+
+_toggl() {
+	local curcontext=$curcontext state line _toggl_profile
+	local -A opt_args
+	_arguments -C \
+`)
+	for _, f := range globals {
+		fmt.Fprintf(w, "\t\t%s \\\n", zshSpec(f))
+	}
+	io.WriteString(w, `		'1:command:->command' \
+		'*::argument:->argument'
+	_toggl_profile=${opt_args[-profile]}
+
+	case $state in
+	command)
+		local -a commands
+		commands=(
+`)
+	for _, cmd := range visibleCommands() {
+		fmt.Fprintf(w, "\t\t\t'%s:%s'\n", cmd.name, zshQuote(cmd.summary))
+	}
+	io.WriteString(w, `		)
+		_describe -t commands command commands
+		;;
+	argument)
+		case $words[1] in
+`)
+	for _, cmd := range visibleCommands() {
+		specs := []string{}
+		for _, f := range commandFlags(cmd) {
+			specs = append(specs, zshSpec(f))
+		}
+		if cmd.complete.words != nil || cmd.complete.dynamic != "" {
+			specs = append(specs, "'*:argument:"+zshQuote(zshValues(cmd.complete))+"'")
+		}
+		fmt.Fprintf(w, "\t\t%s)\n", cmd.name)
+		if len(specs) == 0 {
+			fmt.Fprint(w, "\t\t\t_message 'no arguments'\n")
+		} else {
+			fmt.Fprintf(w, "\t\t\t_arguments \\\n\t\t\t\t%s\n", strings.Join(specs, " \\\n\t\t\t\t"))
+		}
+		fmt.Fprint(w, "\t\t\t;;\n")
+	}
+	io.WriteString(w, `		esac
+		;;
+	esac
+}
+
+if [[ $zsh_eval_context[-1] == loadautofunc ]]; then
+	_toggl "$@"
+else
+	compdef _toggl toggl
+fi
+`)
+}
+
+// fishQuote quotes s as a single quoted fish string.
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// fishValues returns the argument list completing from c.
+func fishValues(c completion) string {
+	return fishQuote("(" + strings.Join(append([]string{"__toggl_values", `"` + c.dynamic + `"`}, c.words...), " ") + ")")
+}
+
+// fishFlags writes the completions of the flags under the condition.
+func fishFlags(w io.Writer, condition string, flags []completionFlag) {
+	for _, f := range flags {
+		fmt.Fprintf(w, "complete -c toggl -n %s -o %s", condition, f.name)
+		if f.arg != "" {
+			fmt.Fprint(w, " -x")
+			if f.values.words != nil || f.values.dynamic != "" {
+				fmt.Fprintf(w, " -a %s", fishValues(f.values))
+			}
+		}
+		fmt.Fprintf(w, " -d %s\n", fishQuote(f.usage))
+	}
+}
+
+func writeFishCompletion(w io.Writer, globals []completionFlag) {
+	io.WriteString(w, `# fish completion for toggl, generated by "toggl completion fish".
+# Load it with: toggl completion fish | source
+
+# __toggl_command prints the command on the command line, skipping the flags before it.
+function __toggl_command
+    set -l tokens (commandline -opc)
+    set -e tokens[1]
+    while set -q tokens[1]
+        switch $tokens[1]
+            case -profile --profile
+                set -e tokens[1]
+                set -q tokens[1]; and set -e tokens[1]
+            case '-*'
+                set -e tokens[1]
+            case '*'
+                echo $tokens[1]
+                return 0
+        end
+    end
+    return 1
+end
+
+# __toggl_using tells whether the command on the command line is $argv[1].
+function __toggl_using
+    set -l cmd (__toggl_command); and test "$cmd" = $argv[1]
+end
+
+# __toggl_values prints the words after $argv[1] and the candidates of kind $argv[1] printed by "toggl __complete".
+function __toggl_values
+    set -l kind $argv[1]
+    set -e argv[1]
+    test (count $argv) -gt 0; and printf '%s\n' $argv
+    if test -n "$kind"
+        set -l tokens (commandline -opc)
+        set -l profile
+        if set -l i (contains -i -- -profile $tokens)
+            set profile -profile $tokens[(math $i + 1)]
+        end
+        toggl $profile __complete $kind 2>/dev/null
+    end
+end
+
+complete -c toggl -f
+`)
+	fishFlags(w, fishQuote("not __toggl_command"), globals)
+	for _, cmd := range visibleCommands() {
+		fmt.Fprintf(w, "complete -c toggl -n %s -a %s -d %s\n", fishQuote("not __toggl_command"), cmd.name, fishQuote(cmd.summary))
+	}
+	for _, cmd := range visibleCommands() {
+		condition := fishQuote("__toggl_using " + cmd.name)
+		fishFlags(w, condition, commandFlags(cmd))
+		if cmd.complete.words != nil || cmd.complete.dynamic != "" {
+			fmt.Fprintf(w, "complete -c toggl -n %s -a %s\n", condition, fishValues(cmd.complete))
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestComplete(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me": meResponse,
+		"GET /api/v9/me/projects": `[
+			{"id":10,"name":"Website","workspace_id":1,"active":true},
+			{"id":11,"name":"Archive","workspace_id":1,"active":false},
+			{"id":12,"name":"App","workspace_id":1,"active":true},
+			{"id":20,"name":"Other","workspace_id":2,"active":true}
+		]`,
+		"GET /api/v9/me/tags": `[
+			{"id":1,"name":"web","workspace_id":1},
+			{"id":2,"name":"docs","workspace_id":1},
+			{"id":3,"name":"old","workspace_id":1,"deleted_at":"2024-01-01T00:00:00Z"}
+		]`,
+		"GET /api/v9/me/time_entries": `[
+			{"id":3,"description":"Review","workspace_id":1},
+			{"id":2,"description":"Write docs","workspace_id":1},
+			{"id":1,"description":"Review","workspace_id":1},
+			{"id":0,"description":"","workspace_id":1}
+		]`,
+	}}
+	test := []struct {
+		kind string
+		want string
+	}{
+		{"commands", "start\nstop\nstatus\ncontinue\nedit\nlist\ngit\nqueue\ndashboard\ndaemon\nexporter\nprofile\nhelp\ncompletion\n"},
+		{"projects", "App\nWebsite\n"},
+		{"tags", "docs\nweb\n"},
+		{"descriptions", "Review\nWrite docs\n"},
+		{"profiles", ""},
+	}
+	for _, tt := range test {
+		t.Run(tt.kind, func(t *testing.T) {
+			e, stdout, stderr := newTestEnv(api)
+			if code := run([]string{"__complete", tt.kind}, e); code != 0 {
+				t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, stdout.String())
+			}
+		})
+	}
+}
+
+func TestCompletionCache(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/projects": `[{"id":10,"name":"Website","workspace_id":1,"active":true}]`,
+	}}
+	e, _, _ := newTestEnv(api)
+	cacheDir := t.TempDir()
+	e.getenv = func(key string) string {
+		if key == "XDG_CACHE_HOME" {
+			return cacheDir
+		}
+		return ""
+	}
+	e.profileName = "work"
+	e.profile = &profile{Aliases: map[string]string{"web": "Website"}}
+
+	now := testNow
+	e.now = func() time.Time { return now }
+	complete := func() []string {
+		t.Helper()
+		got, err := completionCandidates(e, "projects")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	// The workspace of the profile avoids fetching the user.
+	e.profile.Workspace = 1
+	if want := []string{"web", "Website"}; !cmp.Equal(want, complete()) {
+		t.Errorf("diff: %v", cmp.Diff(want, complete()))
+	}
+	if len(api.requests) != 1 {
+		t.Fatalf("Expected 1 request, got %v", api.requests)
+	}
+
+	api.responses["GET /api/v9/me/projects"] = `[{"id":11,"name":"App","workspace_id":1,"active":true}]`
+	now = now.Add(completionTTL - time.Second)
+	if want := []string{"web", "Website"}; !cmp.Equal(want, complete()) {
+		t.Errorf("Expected cached projects, diff: %v", cmp.Diff(want, complete()))
+	}
+	if len(api.requests) != 1 {
+		t.Fatalf("Expected no new request, got %v", api.requests)
+	}
+
+	now = now.Add(time.Second)
+	if want := []string{"web", "App"}; !cmp.Equal(want, complete()) {
+		t.Errorf("Expected fetched projects, diff: %v", cmp.Diff(want, complete()))
+	}
+	if len(api.requests) != 2 {
+		t.Fatalf("Expected a new request, got %v", api.requests)
+	}
+	if got := completionCachePath(e.getenv); got != filepath.Join(cacheDir, "toggl", "completion.json") {
+		t.Errorf("unexpected cache path %q", got)
+	}
+	// The cache is replaced whole, no temporary file is left behind.
+	entries, err := os.ReadDir(filepath.Join(cacheDir, "toggl"))
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("Expected only the cache, got %v, %v", entries, err)
+	}
+	if info, err := entries[0].Info(); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected a cache readable only by the user, got %v, %v", info, err)
+	}
+}
+
+func TestCompletionScripts(t *testing.T) {
+	for _, shell := range shells {
+		t.Run(shell, func(t *testing.T) {
+			e, stdout, stderr := newTestEnv(&fakeAPI{})
+			if code := run([]string{"completion", shell}, e); code != 0 {
+				t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+			}
+			script := stdout.String()
+			for _, cmd := range visibleCommands() {
+				if !strings.Contains(script, cmd.name) {
+					t.Errorf("Expected command %s in the script", cmd.name)
+				}
+			}
+			if strings.Contains(script, "__complete)") || strings.Contains(script, "__toggl_using __complete") {
+				t.Error("Expected no hidden command in the script")
+			}
+			// Check the syntax with the shell if it is installed.
+			path, err := exec.LookPath(shell)
+			if err != nil {
+				t.Skipf("%s is not installed", shell)
+			}
+			flag := "-n"
+			if shell == "fish" {
+				flag = "--no-execute"
+			}
+			cmd := exec.Command(path, flag)
+			cmd.Stdin = strings.NewReader(script)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("invalid %s script: %v\n%s", shell, err, out)
+			}
+		})
+	}
+}
+
+func TestBashCompletion(t *testing.T) {
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash is not installed")
+	}
+	e, stdout, _ := newTestEnv(&fakeAPI{})
+	if code := run([]string{"completion", "bash"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	script := stdout.String() + `
+t() { COMP_WORDS=("$@"); COMP_CWORD=$((${#COMP_WORDS[@]}-1)); _toggl; echo "${COMPREPLY[*]}"; }
+t toggl st
+t toggl -
+t toggl list -o j
+t toggl list this-
+t toggl start -b
+`
+	out, err := exec.Command(bash, "-c", script).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+	want := "start stop status\n-profile\njson jsonl\nthis-week this-month\n-b -billable\n"
+	if string(out) != want {
+		t.Errorf("Expected %q, got %q", want, out)
+	}
+}
+
+func TestBashCompletionLiteral(t *testing.T) {
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash is not installed")
+	}
+	e, stdout, _ := newTestEnv(&fakeAPI{})
+	if code := run([]string{"completion", "bash"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	dir := t.TempDir()
+	// The candidates from Toggl must never be expanded by the shell.
+	script := stdout.String() + `
+toggl() { printf '%s\n' 'Fix $(touch expanded)' 'Fix ` + "`touch expanded`" + `' 'Fix *' 'Other'; }
+cd "$1" || exit 1
+t() { COMP_WORDS=("$@"); COMP_CWORD=$((${#COMP_WORDS[@]}-1)); _toggl; printf '%s\n' "${COMPREPLY[@]}"; }
+t toggl start -d F
+`
+	out, err := exec.Command(bash, "-c", script, "bash", dir).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+	want := "Fix\\ \\$\\(touch\\ expanded\\)\nFix\\ \\`touch\\ expanded\\`\nFix\\ \\*\n"
+	if string(out) != want {
+		t.Errorf("Expected %q, got %q", want, out)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "expanded")); !os.IsNotExist(err) {
+		t.Errorf("expected the candidates not to be expanded, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// config is the configuration file of the CLI.
+type config struct {
+	Current  string              `json:"current,omitempty"` // Name of the profile used by default
+	Profiles map[string]*profile `json:"profiles"`
+}
+
+// profileNameRe matches valid profile names, which are used in the names of the token and queue files.
+var profileNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// validProfileName checks that the profile name is safe to use in a file name.
+func validProfileName(name string) error {
+	if !profileNameRe.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q, use letters, digits, _ and -", name)
+	}
+	return nil
+}
+
+// profile holds the settings of one Toggl account.
+type profile struct {
+	Token     tokenSource       `json:"token"`
+	Workspace int               `json:"workspace,omitempty"` // Default workspace ID
+	Timezone  string            `json:"timezone,omitempty"`  // IANA timezone times are shown in
+	Format    string            `json:"format,omitempty"`    // Default output format of list
+	Aliases   map[string]string `json:"aliases,omitempty"`   // Project names or IDs by alias
+}
+
+// tokenSource tells where the API token of a profile is read from. Exactly one field is set.
+type tokenSource struct {
+	Env     string `json:"env,omitempty"`     // Name of the environment variable
+	File    string `json:"file,omitempty"`    // Path of the file
+	Command string `json:"command,omitempty"` // Shell command printing the token
+}
+
+// String describes the token source.
+func (s tokenSource) String() string {
+	switch {
+	case s.Env != "":
+		return "env " + s.Env
+	case s.File != "":
+		return "file " + s.File
+	case s.Command != "":
+		return "command " + s.Command
+	}
+	return "none"
+}
+
+// configPath returns the path of the configuration file: $TOGGL_CONFIG, or toggl/config.json in
+// $XDG_CONFIG_HOME or ~/.config. It returns an empty string if there is no home directory.
+func configPath(getenv func(string) string) string {
+	if path := getenv("TOGGL_CONFIG"); path != "" {
+		return path
+	}
+	dir := getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home := getenv("HOME")
+		if home == "" {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "toggl", "config.json")
+}
+
+// loadConfig reads the configuration file at path. A missing file is an empty configuration.
+func loadConfig(path string) (*config, error) {
+	c := &config{Profiles: map[string]*profile{}}
+	if path == "" {
+		return c, nil
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("invalid configuration %s: %w", path, err)
+	}
+	if c.Profiles == nil {
+		c.Profiles = map[string]*profile{}
+	}
+	return c, nil
+}
+
+// save writes the configuration to path, readable only by the user.
+func (c *config) save(path string) error {
+	if path == "" {
+		return errors.New("no configuration path, set TOGGL_CONFIG or HOME")
+	}
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(b, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// validate checks the settings of the profile.
+func (p *profile) validate() error {
+	n := 0
+	for _, v := range []string{p.Token.Env, p.Token.File, p.Token.Command} {
+		if v != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("exactly one token source of env, file or command is required")
+	}
+	if p.Timezone != "" {
+		if _, err := time.LoadLocation(p.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q", p.Timezone)
+		}
+	}
+	if p.Format != "" && !slices.Contains(formats, p.Format) {
+		return fmt.Errorf("unknown format %q, use one of %s", p.Format, strings.Join(formats, ", "))
+	}
+	return nil
+}
+
+// token reads the API token from the token source of the profile.
+func (p *profile) token(e *env) (string, error) {
+	var token string
+	switch s := p.Token; {
+	case s.Env != "":
+		token = e.getenv(s.Env)
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s is empty", s.Env)
+		}
+	case s.File != "":
+		b, err := os.ReadFile(s.File)
+		if err != nil {
+			return "", err
+		}
+		token = string(b)
+	case s.Command != "":
+		var stdout bytes.Buffer
+		cmd := exec.Command("sh", "-c", s.Command)
+		cmd.Stdout, cmd.Stderr = &stdout, e.stderr
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("token command: %w", err)
+		}
+		token = stdout.String()
+	default:
+		return "", errors.New("the profile has no token source")
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("empty token from %s", p.Token)
+	}
+	return token, nil
+}
@@ -0,0 +1,397 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// daemonWriteTimeout is how long the daemon waits for a client to read a message.
+const daemonWriteTimeout = 5 * time.Second
+
+// daemonRequest is a request to the daemon. Requests and responses are JSON objects, one per line.
+type daemonRequest struct {
+	Id     int             `json:"id"`
+	Method string          `json:"method"` // status, list, start, stop, refresh or subscribe
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// daemonResponse is the response to the request with the same Id.
+type daemonResponse struct {
+	Id     int    `json:"id"`
+	Result any    `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// daemonEvent is pushed to subscribers when the state changes.
+type daemonEvent struct {
+	Event string      `json:"event"` // Always "state"
+	State daemonState `json:"state"`
+}
+
+// daemonState is the cached state of the daemon.
+type daemonState struct {
+	Current *timeentries.GetTimeEntriesOutput  `json:"current"` // Running time entry, null if none
+	Recent  []timeentries.GetTimeEntriesOutput `json:"recent"`  // Recent time entries, newest first
+	Updated time.Time                          `json:"updated"`
+}
+
+// daemonStartParams are the params of the start method.
+type daemonStartParams struct {
+	Description string   `json:"description"`
+	Project     string   `json:"project"` // Project name, alias or ID
+	Tags        []string `json:"tags"`
+	Billable    bool     `json:"billable"`
+	WorkspaceId int      `json:"workspace_id"` // Defaults to the default workspace
+}
+
+// daemonListParams are the params of the list method.
+type daemonListParams struct {
+	Limit int `json:"limit"` // Maximum number of time entries, all if zero
+}
+
+// daemon serves one Toggl client and its cached state to the clients of a Unix socket.
+type daemon struct {
+	e        *env
+	interval time.Duration
+
+	// api serializes the use of the env and the updates of the state, which are
+	// held while Toggl answers. Requests reading the state never wait for it.
+	api sync.Mutex
+
+	mu          sync.Mutex // Guards the state and the subscribers, never held while sending
+	state       daemonState
+	subscribers map[*daemonConn]bool
+}
+
+// daemonConn is a connection to a client.
+type daemonConn struct {
+	conn   net.Conn
+	mu     sync.Mutex // Serializes the messages
+	enc    *json.Encoder
+	events chan daemonState // Latest state not yet pushed to a subscriber
+}
+
+func (c *daemonConn) send(v any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(daemonWriteTimeout))
+	return c.enc.Encode(v)
+}
+
+// notify queues the state to push to the subscriber, replacing a state not pushed yet,
+// so that a slow subscriber never blocks the daemon. It is called with daemon.mu held.
+func (c *daemonConn) notify(state daemonState) {
+	select {
+	case <-c.events:
+	default:
+	}
+	c.events <- state
+}
+
+// push sends the queued states to the subscriber until it disconnects.
+func (c *daemonConn) push() {
+	for state := range c.events {
+		if err := c.send(daemonEvent{Event: "state", State: state}); err != nil {
+			// Closing the connection ends handle, which unsubscribes.
+			c.conn.Close()
+			return
+		}
+	}
+}
+
+func newDaemon(e *env, interval time.Duration) *daemon {
+	return &daemon{e: e, interval: interval, subscribers: map[*daemonConn]bool{}}
+}
+
+// daemonSocketPath returns the default socket path: toggl.sock in $XDG_RUNTIME_DIR,
+// or in the private directory toggl-<uid> of the temporary directory.
+func daemonSocketPath(getenv func(string) string) string {
+	if dir := getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, "toggl.sock")
+	}
+	return filepath.Join(os.TempDir(), fmt.Sprintf("toggl-%d", os.Getuid()), "toggl.sock")
+}
+
+func setupDaemon(fs *flag.FlagSet) func(e *env, args []string) error {
+	var (
+		socket   string
+		interval time.Duration
+	)
+	fs.StringVar(&socket, "socket", "", "`path` of the Unix socket, defaults to $XDG_RUNTIME_DIR/toggl.sock")
+	fs.DurationVar(&interval, "interval", time.Minute, "how often to fetch the time entries from Toggl")
+	return func(e *env, args []string) error {
+		if len(args) > 0 {
+			return errUsage
+		}
+		if interval <= 0 {
+			return errors.New("the interval must be positive")
+		}
+		if socket == "" {
+			socket = daemonSocketPath(e.getenv)
+		}
+		l, err := listenUnix(socket)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(socket)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		d := newDaemon(e, interval)
+		if _, err := d.refresh(); err != nil {
+			l.Close()
+			return err
+		}
+		fmt.Fprintf(e.stdout, "Listening on %s\n", socket)
+		return d.serve(ctx, l)
+	}
+}
+
+// listenUnix listens on the Unix socket at path, accessible only by the user.
+// A socket left behind by a daemon that is gone is replaced.
+func listenUnix(path string) (net.Listener, error) {
+	// Others could replace the socket in a directory they can write to.
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode().Perm()&0o022 != 0 {
+		return nil, fmt.Errorf("the directory %s of the socket is writable by others", dir)
+	}
+	if _, err := os.Stat(path); err == nil {
+		if c, err := net.Dial("unix", path); err == nil {
+			c.Close()
+			return nil, fmt.Errorf("a daemon is already listening on %s", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	// The socket is bound in a private directory and moved to path once only the user can access it.
+	private, err := os.MkdirTemp(dir, ".toggl")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(private)
+	bound := filepath.Join(private, "toggl.sock")
+	l, err := net.Listen("unix", bound)
+	if err != nil {
+		return nil, err
+	}
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	if err := os.Chmod(bound, 0o600); err != nil {
+		l.Close()
+		return nil, err
+	}
+	if err := os.Rename(bound, path); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// serve accepts clients on l and fetches the state every interval until ctx is done.
+func (d *daemon) serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+	go func() {
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := d.refresh(); err != nil {
+					slog.Error(fmt.Sprintf("Failed to fetch the time entries: %v", err))
+				}
+			}
+		}
+	}()
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go d.handle(conn)
+	}
+}
+
+// handle answers the requests of a client until it disconnects.
+func (d *daemon) handle(conn net.Conn) {
+	c := &daemonConn{conn: conn, enc: json.NewEncoder(conn)}
+	defer func() {
+		d.mu.Lock()
+		if d.subscribers[c] {
+			delete(d.subscribers, c)
+			close(c.events)
+		}
+		d.mu.Unlock()
+		conn.Close()
+	}()
+	sc := bufio.NewScanner(conn)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		req := daemonRequest{}
+		resp := daemonResponse{}
+		if err := json.Unmarshal(sc.Bytes(), &req); err != nil {
+			resp.Error = fmt.Sprintf("invalid request: %v", err)
+		} else {
+			resp.Id = req.Id
+			if resp.Result, err = d.call(c, req); err != nil {
+				resp.Error = err.Error()
+			}
+		}
+		if err := c.send(resp); err != nil {
+			return
+		}
+	}
+}
+
+// call runs the method of the request. status, list and subscribe answer from the
+// cached state without waiting for Toggl.
+func (d *daemon) call(c *daemonConn, req daemonRequest) (any, error) {
+	switch req.Method {
+	case "status":
+		return d.snapshot(), nil
+	case "list":
+		params := daemonListParams{}
+		if err := decodeParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		recent := d.snapshot().Recent
+		if params.Limit > 0 && params.Limit < len(recent) {
+			return recent[:params.Limit], nil
+		}
+		return recent, nil
+	case "subscribe":
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if !d.subscribers[c] {
+			d.subscribers[c] = true
+			c.events = make(chan daemonState, 1)
+			go c.push()
+		}
+		return d.state, nil
+	}
+
+	d.api.Lock()
+	defer d.api.Unlock()
+	switch req.Method {
+	case "start":
+		params := daemonStartParams{}
+		if err := decodeParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		workspaceId, err := d.e.workspaceId(params.WorkspaceId)
+		if err != nil {
+			return nil, err
+		}
+		projectId, err := d.e.resolveProject(workspaceId, params.Project)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := startEntry(d.e, timeentries.PostTimeEntriesBody{
+			Billable:    params.Billable,
+			Description: params.Description,
+			ProjectId:   projectId,
+			Tags:        params.Tags,
+			WorkspaceId: workspaceId,
+		}); err != nil {
+			return nil, err
+		}
+	case "stop":
+		if _, err := stopRunning(d.e); err != nil {
+			return nil, err
+		}
+	case "refresh":
+	default:
+		return nil, fmt.Errorf("unknown method %q", req.Method)
+	}
+	state, err := d.refreshLocked()
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// snapshot returns the cached state. The state is replaced, never changed, so it can be
+// used without the lock.
+func (d *daemon) snapshot() daemonState {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state
+}
+
+func decodeParams(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	return nil
+}
+
+// refresh fetches the state from Toggl and notifies the subscribers if it changed.
+func (d *daemon) refresh() (daemonState, error) {
+	d.api.Lock()
+	defer d.api.Unlock()
+	return d.refreshLocked()
+}
+
+// refreshLocked is refresh with d.api held. The state is fetched without d.mu, and
+// swapped in under it.
+func (d *daemon) refreshLocked() (daemonState, error) {
+	c, err := d.e.togglClient()
+	if err != nil {
+		return daemonState{}, err
+	}
+	current, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+	if err != nil {
+		return daemonState{}, err
+	}
+	recent, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{})
+	if err != nil {
+		return daemonState{}, err
+	}
+	state := daemonState{Recent: recent, Updated: d.e.now()}
+	if current.Id != 0 {
+		state.Current = &current
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	changed := !reflect.DeepEqual(state.Current, d.state.Current) || !reflect.DeepEqual(state.Recent, d.state.Recent)
+	d.state = state
+	if changed {
+		for s := range d.subscribers {
+			s.notify(state)
+		}
+	}
+	return state, nil
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// startTestDaemon serves a daemon on a temporary socket and returns its path.
+func startTestDaemon(t *testing.T, api *fakeAPI) string {
+	t.Helper()
+	e, _, _ := newTestEnv(api)
+	return serveTestDaemon(t, e)
+}
+
+// serveTestDaemon serves a daemon using e on a temporary socket and returns its path.
+func serveTestDaemon(t *testing.T, e *env) string {
+	t.Helper()
+	// Unix socket paths are short, so t.TempDir may be too long.
+	dir, err := os.MkdirTemp("", "toggl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "toggl.sock")
+	l, err := listenUnix(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := newDaemon(e, time.Hour)
+	if _, err := d.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- d.serve(ctx, l) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	})
+	return path
+}
+
+type testDaemonClient struct {
+	t    *testing.T
+	conn net.Conn
+	sc   *bufio.Scanner
+}
+
+func dialTestDaemon(t *testing.T, path string) *testDaemonClient {
+	t.Helper()
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	return &testDaemonClient{t: t, conn: conn, sc: bufio.NewScanner(conn)}
+}
+
+func (c *testDaemonClient) send(request string) {
+	c.t.Helper()
+	if _, err := c.conn.Write([]byte(request + "\n")); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// receive decodes the next message into v.
+func (c *testDaemonClient) receive(v any) {
+	c.t.Helper()
+	if !c.sc.Scan() {
+		c.t.Fatalf("no message: %v", c.sc.Err())
+	}
+	if err := json.Unmarshal(c.sc.Bytes(), v); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+type testStateResponse struct {
+	Id     int         `json:"id"`
+	Result daemonState `json:"result"`
+	Error  string      `json:"error"`
+}
+
+func TestDaemonStatusAndList(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current": `{"id":3,"description":"Review","duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1}`,
+		"GET /api/v9/me/time_entries":         dashboardEntries,
+	}}
+	c := dialTestDaemon(t, startTestDaemon(t, api))
+
+	c.send(`{"id":1,"method":"status"}`)
+	status := testStateResponse{}
+	c.receive(&status)
+	if status.Id != 1 || status.Error != "" || status.Result.Current == nil || status.Result.Current.Description != "Review" {
+		t.Errorf("unexpected status: %+v", status)
+	}
+	if len(status.Result.Recent) != 3 {
+		t.Errorf("expected 3 recent time entries, got %d", len(status.Result.Recent))
+	}
+
+	c.send(`{"id":2,"method":"list","params":{"limit":2}}`)
+	list := struct {
+		Id     int `json:"id"`
+		Result []struct {
+			Id int `json:"id"`
+		} `json:"result"`
+	}{}
+	c.receive(&list)
+	got := []int{}
+	for _, te := range list.Result {
+		got = append(got, te.Id)
+	}
+	if want := []int{3, 2}; list.Id != 2 || !cmp.Equal(want, got) {
+		t.Errorf("expected time entries %v, got %v", want, got)
+	}
+
+	// Status and list are served from the cache.
+	want := []string{"GET /api/v9/me/time_entries/current", "GET /api/v9/me/time_entries"}
+	if !cmp.Equal(want, api.requests) {
+		t.Errorf("diff: %v", cmp.Diff(want, api.requests))
+	}
+}
+
+func TestDaemonErrors(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{}}
+	c := dialTestDaemon(t, startTestDaemon(t, api))
+	test := []struct {
+		request string
+		want    daemonResponse
+	}{
+		{`{"id":1,"method":"nap"}`, daemonResponse{Id: 1, Error: `unknown method "nap"`}},
+		{`{"id":2,"method":"list","params":[1]}`, daemonResponse{Id: 2, Error: "invalid params: json: cannot unmarshal array into Go value of type main.daemonListParams"}},
+		{`nap`, daemonResponse{Error: "invalid request: invalid character 'a' in literal null (expecting 'u')"}},
+	}
+	for _, tt := range test {
+		c.send(tt.request)
+		got := daemonResponse{}
+		c.receive(&got)
+		if !cmp.Equal(tt.want, got) {
+			t.Errorf("%s: diff: %v", tt.request, cmp.Diff(tt.want, got))
+		}
+	}
+}
+
+func TestDaemonSubscribe(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries": `[]`,
+	}}
+	path := startTestDaemon(t, api)
+	subscriber := dialTestDaemon(t, path)
+	subscriber.send(`{"id":1,"method":"subscribe"}`)
+	subscribed := testStateResponse{}
+	subscriber.receive(&subscribed)
+	if subscribed.Error != "" || subscribed.Result.Current != nil {
+		t.Fatalf("unexpected response: %+v", subscribed)
+	}
+
+	api.responses["POST /api/v9/workspaces/1/time_entries"] = `{"id":4,"description":"Deploy","duration":-1,"start":"2024-05-15T10:00:00+00:00","workspace_id":1}`
+	api.responses["GET /api/v9/me/time_entries/current"] = api.responses["POST /api/v9/workspaces/1/time_entries"]
+	api.responses["GET /api/v9/me/time_entries"] = "[" + api.responses["POST /api/v9/workspaces/1/time_entries"] + "]"
+	c := dialTestDaemon(t, path)
+	c.send(`{"id":7,"method":"start","params":{"description":"Deploy","workspace_id":1}}`)
+	started := testStateResponse{}
+	c.receive(&started)
+	if started.Id != 7 || started.Error != "" || started.Result.Current == nil || started.Result.Current.Id != 4 {
+		t.Errorf("unexpected response: %+v", started)
+	}
+
+	event := daemonEvent{}
+	subscriber.receive(&event)
+	if event.Event != "state" || event.State.Current == nil || event.State.Current.Description != "Deploy" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+}
+
+// blockingAPI holds the requests with the method until released.
+type blockingAPI struct {
+	next    *fakeAPI
+	method  string
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingAPI) Do(r *http.Request) (*http.Response, error) {
+	if r.Method == b.method {
+		b.started <- struct{}{}
+		<-b.release
+	}
+	return b.next.Do(r)
+}
+
+func TestDaemonStatusWhileBusy(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current":            `{"id":3,"description":"Review","duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1}`,
+		"GET /api/v9/me/time_entries":                    `[]`,
+		"PATCH /api/v9/workspaces/1/time_entries/3/stop": `{"id":3,"description":"Review","duration":1800,"workspace_id":1}`,
+	}}
+	e, _, _ := newTestEnv(api)
+	blocking := &blockingAPI{next: api, method: http.MethodPatch, started: make(chan struct{}), release: make(chan struct{})}
+	e.client.TimeEntriesClient.HttpClient = blocking
+	path := serveTestDaemon(t, e)
+
+	stopper := dialTestDaemon(t, path)
+	stopper.send(`{"id":1,"method":"stop"}`)
+	<-blocking.started
+
+	// The status is answered from the cache while Toggl has not answered the stop.
+	c := dialTestDaemon(t, path)
+	c.send(`{"id":2,"method":"status"}`)
+	status := testStateResponse{}
+	c.receive(&status)
+	if status.Id != 2 || status.Result.Current == nil || status.Result.Current.Id != 3 {
+		t.Errorf("unexpected status: %+v", status)
+	}
+
+	close(blocking.release)
+	stopped := testStateResponse{}
+	stopper.receive(&stopped)
+	if stopped.Id != 1 || stopped.Error != "" {
+		t.Errorf("unexpected response: %+v", stopped)
+	}
+}
+
+func TestListenUnixPrivate(t *testing.T) {
+	dir, err := os.MkdirTemp("", "toggl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "run", "toggl.sock")
+	l, err := listenUnix(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	for p, want := range map[string]os.FileMode{filepath.Dir(path): 0o700, path: 0o600} {
+		if info, err := os.Stat(p); err != nil || info.Mode().Perm() != want {
+			t.Errorf("Expected %s with mode %v, got %v, %v", p, want, info, err)
+		}
+	}
+	if entries, err := os.ReadDir(filepath.Dir(path)); err != nil || len(entries) != 1 {
+		t.Errorf("Expected only the socket, got %v, %v", entries, err)
+	}
+
+	// Others could replace the socket in a directory writable by them.
+	shared := filepath.Join(dir, "shared")
+	if err := os.Mkdir(shared, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(shared, 0o777); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := listenUnix(filepath.Join(shared, "toggl.sock")); err == nil {
+		t.Error("expected an error for a directory writable by others")
+	}
+}
+
+func TestDaemonSocketPath(t *testing.T) {
+	if got := daemonSocketPath(func(string) string { return "/run/user/1000" }); got != "/run/user/1000/toggl.sock" {
+		t.Errorf("unexpected path: %s", got)
+	}
+	want := filepath.Join(os.TempDir(), fmt.Sprintf("toggl-%d", os.Getuid()), "toggl.sock")
+	if got := daemonSocketPath(func(string) string { return "" }); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestListenUnixRunning(t *testing.T) {
+	path := startTestDaemon(t, &fakeAPI{responses: map[string]string{}})
+	if _, err := listenUnix(path); err == nil {
+		t.Error("expected an error for a socket in use")
+	}
+}
@@ -0,0 +1,671 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// dashboardRefresh is how often the dashboard fetches the time entries again.
+const dashboardRefresh = 30 * time.Second
+
+// dashboardRetry is how long the dashboard waits to fetch again after a failed fetch,
+// doubled after each further failure up to dashboardMaxRetry.
+const (
+	dashboardRetry    = 5 * time.Second
+	dashboardMaxRetry = 5 * time.Minute
+)
+
+// dashboardView selects the time entries listed by the dashboard.
+type dashboardView int
+
+const (
+	viewToday dashboardView = iota
+	viewWeek
+)
+
+// dashboard is the state of the interactive dashboard.
+type dashboard struct {
+	e *env
+	dashboardData
+	view dashboardView
+
+	selected int // Index of the selected time entry in the list
+	offset   int // Index of the first time entry shown in the list
+	width    int
+	height   int
+
+	status  string
+	prompt  *prompt
+	confirm action // Runs if the question in the status is answered with y
+
+	// Actions run in the background one at a time, so that the dashboard keeps
+	// drawing while Toggl answers. Only they use the env.
+	busy    string         // Label of the running action, empty if none
+	pending []queuedAction // Actions to run after it, in order
+	results chan actionResult
+
+	attempted time.Time // Time of the last fetch
+	failures  int       // Number of fetches failed in a row
+}
+
+// dashboardData is what the dashboard fetches from Toggl.
+type dashboardData struct {
+	running  timeentries.GetTimeEntriesOutput   // Running time entry, empty if none
+	entries  []timeentries.GetTimeEntriesOutput // Time entries of this week, newest first
+	projects map[int]string                     // Project names by ID
+}
+
+// action changes time entries in Toggl and returns the status to show.
+type action func() (string, error)
+
+// queuedAction is an action waiting for the running one.
+type queuedAction struct {
+	label string
+	act   action
+}
+
+// actionResult is the outcome of an action and the data fetched after it.
+type actionResult struct {
+	status string
+	data   dashboardData
+	err    error
+}
+
+// prompt is a line entered in the status line.
+type prompt struct {
+	label string
+	input []rune
+	done  func(value string) (string, error)
+}
+
+func setupDashboard(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) > 0 {
+			return errUsage
+		}
+		term, err := openTerminal(e.stdin)
+		if err != nil {
+			return err
+		}
+		defer term.restore()
+
+		// Errors are shown in the status line, logs would garble the screen.
+		logger := slog.Default()
+		slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+		defer slog.SetDefault(logger)
+
+		io.WriteString(e.stdout, "\x1b[?1049h\x1b[?25l")
+		defer io.WriteString(e.stdout, "\x1b[?25h\x1b[?1049l")
+
+		d := &dashboard{e: e, width: 80, height: 24}
+		if err := d.refresh(); err != nil {
+			d.status = "Error: " + err.Error()
+			d.failures++
+		}
+		d.attempted = e.now()
+		keys := term.keys()
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			if rows, cols, err := term.size(); err == nil {
+				d.width, d.height = cols, rows
+			}
+			io.WriteString(e.stdout, d.render())
+			select {
+			case key, ok := <-keys:
+				if !ok || d.key(key) {
+					return nil
+				}
+			case r := <-d.results:
+				d.finish(r)
+			case <-ticker.C:
+				if d.busy == "" && e.now().Sub(d.attempted) >= d.refreshDelay() {
+					d.run("Refreshing", func() (string, error) { return "", nil })
+				}
+			}
+		}
+	}
+}
+
+// weekStart returns the start of the week of now, in the timezone of now.
+func weekStart(now time.Time) time.Time {
+	start, _, _ := relativeRange("this-week", now)
+	return start
+}
+
+// refresh fetches the running time entry and the time entries of this week.
+func (d *dashboard) refresh() error {
+	data, err := d.fetch()
+	if err != nil {
+		return err
+	}
+	d.apply(data)
+	return nil
+}
+
+// fetch fetches the running time entry, the time entries of this week and their project names.
+func (d *dashboard) fetch() (dashboardData, error) {
+	c, err := d.e.togglClient()
+	if err != nil {
+		return dashboardData{}, err
+	}
+	now := d.e.now().In(d.e.location)
+	start := weekStart(now)
+	startDate, endDate := start.Format(time.RFC3339), start.AddDate(0, 0, 7).Format(time.RFC3339)
+	entries, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{
+		Query: timeentries.GetTimeEntriesQuery{Meta: true, StartDate: &startDate, EndDate: &endDate},
+	})
+	if err != nil {
+		return dashboardData{}, err
+	}
+	running, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+	if err != nil {
+		return dashboardData{}, err
+	}
+	// Time entries are returned newest first, keep them so for a stable selection.
+	slices.SortStableFunc(entries, func(a, b timeentries.GetTimeEntriesOutput) int {
+		as, _ := entryStart(a, time.UTC)
+		bs, _ := entryStart(b, time.UTC)
+		return bs.Compare(as)
+	})
+	projects := map[int]string{}
+	for _, te := range append(entries, running) {
+		if te.ProjectId != 0 {
+			projects[te.ProjectId] = entryProject(d.e, te)
+		}
+	}
+	return dashboardData{running: running, entries: entries, projects: projects}, nil
+}
+
+// refreshDelay returns how long after the last fetch to fetch again, longer after failed fetches.
+func (d *dashboard) refreshDelay() time.Duration {
+	if d.failures == 0 {
+		return dashboardRefresh
+	}
+	return min(dashboardRetry<<min(d.failures-1, 10), dashboardMaxRetry)
+}
+
+// apply shows the fetched data.
+func (d *dashboard) apply(data dashboardData) {
+	d.dashboardData = data
+	d.selected = min(d.selected, max(len(d.listed())-1, 0))
+}
+
+// projectName returns the name of the project of the time entry, from the fetched data.
+func (d *dashboard) projectName(te timeentries.GetTimeEntriesOutput) string {
+	if te.ProjectName != "" {
+		return te.ProjectName
+	}
+	return d.projects[te.ProjectId]
+}
+
+// listed returns the time entries of the view.
+func (d *dashboard) listed() []timeentries.GetTimeEntriesOutput {
+	if d.view == viewWeek {
+		return d.entries
+	}
+	today := d.e.now().In(d.e.location).Format(time.DateOnly)
+	var entries []timeentries.GetTimeEntriesOutput
+	for _, te := range d.entries {
+		if start, err := entryStart(te, d.e.location); err == nil && start.Format(time.DateOnly) == today {
+			entries = append(entries, te)
+		}
+	}
+	return entries
+}
+
+// key handles a key and reports whether the dashboard should quit.
+func (d *dashboard) key(key string) bool {
+	if key == "ctrl-c" {
+		return true
+	}
+	if d.prompt != nil {
+		d.promptKey(key)
+		return false
+	}
+	if d.confirm != nil {
+		confirm := d.confirm
+		d.confirm, d.status = nil, ""
+		if key == "y" || key == "Y" {
+			d.run("Deleting", confirm)
+		}
+		return false
+	}
+
+	listed := d.listed()
+	var selected timeentries.GetTimeEntriesOutput
+	if d.selected < len(listed) {
+		selected = listed[d.selected]
+	}
+	d.status = ""
+	switch key {
+	case "q", "esc":
+		return true
+	case "up", "k":
+		d.selected = max(d.selected-1, 0)
+	case "down", "j":
+		d.selected = max(min(d.selected+1, len(listed)-1), 0)
+	case "pgup":
+		d.selected = max(d.selected-d.listHeight(), 0)
+	case "pgdown":
+		d.selected = max(min(d.selected+d.listHeight(), len(listed)-1), 0)
+	case "home", "g":
+		d.selected = 0
+	case "end", "G":
+		d.selected = max(len(listed)-1, 0)
+	case "tab":
+		d.view = 1 - d.view
+		d.selected, d.offset = 0, 0
+	case "r":
+		d.run("Refreshing", func() (string, error) { return "", nil })
+	case "s":
+		d.prompt = &prompt{label: "Start (description @project #tag): ", done: d.start}
+	case "x":
+		d.run("Stopping", func() (string, error) {
+			te, err := stopRunning(d.e)
+			if err != nil {
+				return "", err
+			}
+			if te.Id == 0 {
+				return "No time entry is running", nil
+			}
+			return "Stopped " + describeStopped(d.e, te), nil
+		})
+	case "c", "enter":
+		if selected.Id == 0 {
+			return false
+		}
+		d.run("Continuing", func() (string, error) {
+			_, te, err := continueEntry(d.e, selected)
+			if err != nil {
+				return "", err
+			}
+			return "Continued " + describe(te, d.e.projectName(te.ProjectId)), nil
+		})
+	case "d":
+		if selected.Id == 0 {
+			return false
+		}
+		project := d.projectName(selected)
+		d.status = fmt.Sprintf("Delete %s? [y/N]", describe(selected, project))
+		d.confirm = func() (string, error) {
+			c, err := d.e.togglClient()
+			if err != nil {
+				return "", err
+			}
+			if err := c.TimeEntriesClient.DeleteTimeEntries(timeentries.DeleteTimeEntriesInput{
+				WorkspaceId: selected.WorkspaceId,
+				TimeEntryId: selected.Id,
+			}); err != nil {
+				return "", err
+			}
+			return "Deleted " + describe(selected, project), nil
+		}
+	case "e":
+		if selected.Id == 0 {
+			return false
+		}
+		d.prompt = &prompt{label: "Description: ", input: []rune(selected.Description), done: func(value string) (string, error) {
+			return d.rename(selected, value)
+		}}
+	}
+	return false
+}
+
+// promptKey edits the line of the prompt.
+func (d *dashboard) promptKey(key string) {
+	p := d.prompt
+	switch key {
+	case "esc":
+		d.prompt = nil
+	case "enter":
+		d.prompt = nil
+		value := strings.TrimSpace(string(p.input))
+		d.run("Saving", func() (string, error) { return p.done(value) })
+	case "backspace":
+		if len(p.input) > 0 {
+			p.input = p.input[:len(p.input)-1]
+		}
+	default:
+		if utf8.RuneCountInString(key) == 1 {
+			p.input = append(p.input, []rune(key)...)
+		}
+	}
+}
+
+// run runs the action in the background and fetches the data again, showing the label
+// in the status line meanwhile. The result is shown by finish. While another action
+// is running, the action is queued to run after it.
+func (d *dashboard) run(label string, act action) {
+	if d.busy != "" {
+		d.pending = append(d.pending, queuedAction{label: label, act: act})
+		return
+	}
+	if d.results == nil {
+		d.results = make(chan actionResult, 1)
+	}
+	d.busy = label
+	go func() {
+		r := actionResult{}
+		r.status, r.err = act()
+		if r.err == nil {
+			r.data, r.err = d.fetch()
+		}
+		d.results <- r
+	}()
+}
+
+// finish shows the result of the action, an error in the status line, and runs the
+// next queued action. The queued actions are dropped after an error, as they were
+// chosen before it.
+func (d *dashboard) finish(r actionResult) {
+	d.busy = ""
+	d.attempted = d.e.now()
+	switch {
+	case r.err != nil:
+		d.failures++
+		d.status = "Error: " + r.err.Error()
+		if len(d.pending) > 0 {
+			d.status += fmt.Sprintf(" (%d queued actions dropped)", len(d.pending))
+			d.pending = nil
+		}
+	default:
+		d.failures = 0
+		d.apply(r.data)
+		if r.status != "" {
+			d.status = r.status
+		}
+	}
+	if len(d.pending) > 0 {
+		next := d.pending[0]
+		d.pending = d.pending[1:]
+		d.run(next.label, next.act)
+	}
+}
+
+// start starts a time entry from a line like "Write docs @Website #docs".
+func (d *dashboard) start(line string) (string, error) {
+	var words, tags []string
+	var project string
+	for _, word := range strings.Fields(line) {
+		switch {
+		case strings.HasPrefix(word, "@") && len(word) > 1:
+			project = word[1:]
+		case strings.HasPrefix(word, "#") && len(word) > 1:
+			tags = append(tags, word[1:])
+		default:
+			words = append(words, word)
+		}
+	}
+	workspaceId, err := d.e.workspaceId(0)
+	if err != nil {
+		return "", err
+	}
+	projectId, err := d.e.resolveProject(workspaceId, project)
+	if err != nil {
+		return "", err
+	}
+	if _, err := stopRunning(d.e); err != nil {
+		return "", err
+	}
+	te, err := startEntry(d.e, timeentries.PostTimeEntriesBody{
+		Description: strings.Join(words, " "),
+		ProjectId:   projectId,
+		Tags:        tags,
+		WorkspaceId: workspaceId,
+	})
+	if err != nil {
+		return "", err
+	}
+	return "Started " + describe(te, d.e.projectName(te.ProjectId)), nil
+}
+
+// rename changes the description of the time entry.
+func (d *dashboard) rename(te timeentries.GetTimeEntriesOutput, description string) (string, error) {
+	if description == te.Description {
+		return "", nil
+	}
+	if description == "" {
+		return "", errors.New("the description cannot be cleared")
+	}
+	c, err := d.e.togglClient()
+	if err != nil {
+		return "", err
+	}
+	updated, err := c.TimeEntriesClient.PutTimeEntries(timeentries.PutTimeEntriesInput{
+		WorkspaceId: te.WorkspaceId,
+		TimeEntryId: te.Id,
+		Body:        timeentries.PutTimeEntriesBody{Description: description, WorkspaceId: te.WorkspaceId},
+	})
+	if err != nil {
+		return "", err
+	}
+	return "Updated " + describe(updated, d.e.projectName(updated.ProjectId)), nil
+}
+
+// projectTotal is the time tracked on a project per day of the week.
+type projectTotal struct {
+	name  string
+	days  [7]time.Duration // Monday first
+	total time.Duration
+}
+
+// projectTotals returns the time tracked per project and day of the week, most tracked first.
+func (d *dashboard) projectTotals(now time.Time) []projectTotal {
+	monday := weekStart(now)
+	byName := map[string]*projectTotal{}
+	var totals []*projectTotal
+	for _, te := range d.entries {
+		start, err := entryStart(te, now.Location())
+		if err != nil || start.Before(monday) || !start.Before(monday.AddDate(0, 0, 7)) {
+			continue
+		}
+		day := (int(start.Weekday()) + 6) % 7
+		name := d.projectName(te)
+		if name == "" {
+			name = "(no project)"
+		}
+		t, ok := byName[name]
+		if !ok {
+			t = &projectTotal{name: name}
+			byName[name] = t
+			totals = append(totals, t)
+		}
+		dur := elapsed(te, now)
+		t.days[day] += dur
+		t.total += dur
+	}
+	slices.SortStableFunc(totals, func(a, b *projectTotal) int { return cmp.Compare(b.total, a.total) })
+	result := make([]projectTotal, len(totals))
+	for i, t := range totals {
+		result[i] = *t
+	}
+	return result
+}
+
+// listHeight returns the number of time entries shown in the list.
+func (d *dashboard) listHeight() int {
+	// Title, running, totals, blank, list title and header above; blank, project
+	// totals title, header, rows and total below; blank, status and keys at the bottom.
+	fixed := 6 + 4 + len(d.projectTotals(d.e.now().In(d.e.location))) + 3
+	return max(d.height-fixed, 3)
+}
+
+// tabulate aligns the tab separated lines.
+func tabulate(lines []string) []string {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	w.Flush()
+	return strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+}
+
+// render returns the escape sequences drawing the dashboard.
+func (d *dashboard) render() string {
+	now := d.e.now().In(d.e.location)
+	var lines []string
+	title := "Toggl Track  " + now.Format("Mon 2006-01-02 15:04:05")
+	if d.e.profileName != "" {
+		title += "  profile " + printable(d.e.profileName)
+	}
+	lines = append(lines, "\x1b[1m"+title+"\x1b[0m")
+	if d.running.Id == 0 {
+		lines = append(lines, "■ No time entry is running")
+	} else {
+		lines = append(lines, fmt.Sprintf("▶ %s  \x1b[1m%s\x1b[0m", printable(describe(d.running, d.projectName(d.running))), formatElapsed(elapsed(d.running, now))))
+	}
+
+	var today, week time.Duration
+	for _, te := range d.entries {
+		dur := elapsed(te, now)
+		week += dur
+		if start, err := entryStart(te, d.e.location); err == nil && start.Format(time.DateOnly) == now.Format(time.DateOnly) {
+			today += dur
+		}
+	}
+	lines = append(lines, fmt.Sprintf("Today %s  This week %s", formatElapsed(today), formatElapsed(week)), "")
+
+	listed := d.listed()
+	name := "Today"
+	if d.view == viewWeek {
+		name = "This week"
+	}
+	lines = append(lines, fmt.Sprintf("\x1b[1m%s\x1b[0m (%d time entries, tab to switch)", name, len(listed)))
+	rows := []string{"DATE\tSTART\tSTOP\tDURATION\tPROJECT\tDESCRIPTION"}
+	for _, te := range listed {
+		start, _ := entryStart(te, d.e.location)
+		stop := "running"
+		if te.Duration >= 0 {
+			stop = start.Add(time.Duration(te.Duration) * time.Second).Format("15:04")
+		}
+		description := te.Description
+		if len(te.Tags) > 0 {
+			description += " #" + strings.Join(te.Tags, " #")
+		}
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s",
+			start.Format("Mon 01-02"), start.Format("15:04"), stop, formatElapsed(elapsed(te, now)), printable(d.projectName(te)), printable(description)))
+	}
+	table := tabulate(rows)
+	lines = append(lines, table[0])
+	height := d.listHeight()
+	if d.selected < d.offset {
+		d.offset = d.selected
+	}
+	if d.selected >= d.offset+height {
+		d.offset = d.selected - height + 1
+	}
+	for i := d.offset; i < d.offset+height; i++ {
+		switch {
+		case i >= len(listed):
+			lines = append(lines, "")
+		case i == d.selected:
+			lines = append(lines, "\x1b[7m"+pad(table[i+1], d.width)+"\x1b[0m")
+		default:
+			lines = append(lines, table[i+1])
+		}
+	}
+
+	lines = append(lines, "", "\x1b[1mProject totals this week\x1b[0m")
+	rows = []string{"PROJECT\tMON\tTUE\tWED\tTHU\tFRI\tSAT\tSUN\tTOTAL"}
+	var days [7]time.Duration
+	for _, t := range d.projectTotals(now) {
+		row := printable(t.name)
+		for i, dur := range t.days {
+			row += "\t" + formatElapsed(dur)
+			days[i] += dur
+		}
+		rows = append(rows, row+"\t"+formatElapsed(t.total))
+	}
+	row := "TOTAL"
+	for _, dur := range days {
+		row += "\t" + formatElapsed(dur)
+	}
+	rows = append(rows, row+"\t"+formatElapsed(week))
+	lines = append(lines, tabulate(rows)...)
+
+	lines = append(lines, "")
+	switch {
+	case d.prompt != nil:
+		lines = append(lines, d.prompt.label+printable(string(d.prompt.input))+"█")
+	case d.busy != "" && len(d.pending) > 0:
+		lines = append(lines, fmt.Sprintf("%s… (%d queued)", d.busy, len(d.pending)))
+	case d.busy != "":
+		lines = append(lines, d.busy+"…")
+	default:
+		lines = append(lines, printable(d.status))
+	}
+	lines = append(lines, "\x1b[2m[s]tart  [x] stop  [c]ontinue  [e]dit  [d]elete  [r]efresh  [tab] today/week  [q]uit\x1b[0m")
+
+	// Lines are overwritten and cleared to their end instead of clearing the screen, which flickers.
+	var b strings.Builder
+	b.WriteString("\x1b[H")
+	for i, line := range lines {
+		if i == d.height {
+			break
+		}
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(truncate(line, d.width) + "\x1b[K")
+	}
+	b.WriteString("\x1b[J")
+	return b.String()
+}
+
+// printable replaces the control characters in s, which come from Toggl and could
+// move the cursor or change the terminal, with spaces for white space and ? otherwise.
+func printable(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return ' '
+		case unicode.IsControl(r):
+			return '?'
+		}
+		return r
+	}, s)
+}
+
+// pad pads s with spaces to width runes.
+func pad(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+// truncate cuts s to width visible runes, keeping the escape sequences.
+func truncate(s string, width int) string {
+	var b strings.Builder
+	visible, escape := 0, false
+	for _, r := range s {
+		switch {
+		case escape:
+			// A control sequence ends with a rune from @ to ~, other than the [ after ESC.
+			escape = r == '[' || r < '@' || r > '~'
+		case r == '\x1b':
+			escape = true
+		case visible == width:
+			continue
+		default:
+			visible++
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
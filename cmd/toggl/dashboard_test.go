@@ -0,0 +1,277 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// dashboardEntries are entries of the week of testNow, Wednesday 2024-05-15 10:00 UTC.
+const dashboardEntries = `[
+	{"id":3,"description":"Review","project_id":10,"project_name":"Website","duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1},
+	{"id":2,"description":"Write docs","project_id":10,"project_name":"Website","tags":["docs"],"duration":1800,"start":"2024-05-15T08:00:00+00:00","workspace_id":1},
+	{"id":1,"description":"Standup","duration":900,"start":"2024-05-13T07:00:00+00:00","workspace_id":1}
+]`
+
+var escapes = regexp.MustCompile("\x1b\\[[0-9;?]*[@-~]")
+
+func newTestDashboard(t *testing.T, api *fakeAPI) *dashboard {
+	t.Helper()
+	api.responses["GET /api/v9/me/time_entries"] = dashboardEntries
+	api.responses["GET /api/v9/me/time_entries/current"] = `{"id":3,"description":"Review","project_id":10,"duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1}`
+	api.responses["GET /api/v9/me/projects"] = projectsResponse
+	e, _, _ := newTestEnv(api)
+	d := &dashboard{e: e, width: 100, height: 30}
+	if err := d.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestParseKeys(t *testing.T) {
+	got := parseKeys([]byte("ab\x1b[A\x1b[B\x1b[5~\r\t\x7f\x03\x1bé "))
+	want := []string{"a", "b", "up", "down", "pgup", "enter", "tab", "backspace", "ctrl-c", "esc", "é", " "}
+	if !cmp.Equal(want, got) {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	test := []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"hello", 10, "hello"},
+		{"hello", 3, "hel"},
+		{"\x1b[1mhello\x1b[0m", 3, "\x1b[1mhel\x1b[0m"},
+		{"▶ run", 3, "▶ r"},
+	}
+	for _, tt := range test {
+		if got := truncate(tt.s, tt.width); got != tt.want {
+			t.Errorf("truncate(%q, %d): expected %q, got %q", tt.s, tt.width, tt.want, got)
+		}
+	}
+}
+
+func TestDashboardRender(t *testing.T) {
+	d := newTestDashboard(t, &fakeAPI{responses: map[string]string{}})
+	screen := escapes.ReplaceAllString(d.render(), "")
+	lines := strings.Split(screen, "\r\n")
+	if len(lines) != d.height {
+		t.Errorf("Expected %d lines, got %d", d.height, len(lines))
+	}
+	for _, want := range []string{
+		"Toggl Track  Wed 2024-05-15 10:00:00",
+		`▶ "Review" [Website]  0:30:00`,
+		"Today 1:00:00  This week 1:15:00",
+		"Today (2 time entries, tab to switch)",
+		"Wed 05-15  09:30  running  0:30:00   Website  Review",
+		"Wed 05-15  08:00  08:30    0:30:00   Website  Write docs #docs",
+		"Website       0:00:00  0:00:00  1:00:00  0:00:00  0:00:00  0:00:00  0:00:00  1:00:00",
+		"(no project)  0:15:00  0:00:00  0:00:00  0:00:00  0:00:00  0:00:00  0:00:00  0:15:00",
+		"TOTAL         0:15:00  0:00:00  1:00:00  0:00:00  0:00:00  0:00:00  0:00:00  1:15:00",
+	} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("Expected %q in\n%s", want, screen)
+		}
+	}
+
+	d.key("tab")
+	screen = escapes.ReplaceAllString(d.render(), "")
+	for _, want := range []string{"This week (3 time entries, tab to switch)", "Mon 05-13  07:00  07:15    0:15:00            Standup"} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("Expected %q in\n%s", want, screen)
+		}
+	}
+}
+
+func TestDashboardKeys(t *testing.T) {
+	test := []struct {
+		name       string
+		keys       []string
+		responses  map[string]string
+		wantStatus string
+		wantCall   string
+		wantBody   string
+	}{
+		{
+			name:       "stop",
+			keys:       []string{"x"},
+			responses:  map[string]string{"PATCH /api/v9/workspaces/1/time_entries/3/stop": `{"id":3,"description":"Review","duration":1800,"workspace_id":1}`},
+			wantStatus: `Stopped "Review" after 0:30:00`,
+			wantCall:   "PATCH /api/v9/workspaces/1/time_entries/3/stop",
+		},
+		{
+			name: "continue",
+			keys: []string{"down", "c"},
+			responses: map[string]string{
+				"PATCH /api/v9/workspaces/1/time_entries/3/stop": `{"id":3,"duration":1800,"workspace_id":1}`,
+				"POST /api/v9/workspaces/1/time_entries":         `{"id":4,"description":"Write docs","project_id":10,"workspace_id":1}`,
+			},
+			wantStatus: `Continued "Write docs" [Website]`,
+			wantCall:   "POST /api/v9/workspaces/1/time_entries",
+			wantBody:   `"description":"Write docs"`,
+		},
+		{
+			name:       "delete",
+			keys:       []string{"down", "d", "y"},
+			responses:  map[string]string{"DELETE /api/v9/workspaces/1/time_entries/2": ``},
+			wantStatus: `Deleted "Write docs" [Website] #docs`,
+			wantCall:   "DELETE /api/v9/workspaces/1/time_entries/2",
+		},
+		{
+			name:       "delete cancelled",
+			keys:       []string{"d", "n"},
+			wantStatus: "",
+			wantCall:   "",
+		},
+		{
+			name: "start",
+			keys: append(append([]string{"s"}, strings.Split("Fix bug @website #urgent", "")...), "enter"),
+			responses: map[string]string{
+				"GET /api/v9/me": meResponse,
+				"PATCH /api/v9/workspaces/1/time_entries/3/stop": `{"id":3,"duration":1800,"workspace_id":1}`,
+				"POST /api/v9/workspaces/1/time_entries":         `{"id":4,"description":"Fix bug","project_id":10,"tags":["urgent"],"workspace_id":1}`,
+			},
+			wantStatus: `Started "Fix bug" [Website] #urgent`,
+			wantCall:   "POST /api/v9/workspaces/1/time_entries",
+			wantBody:   `"project_id":10,"start":"2024-05-15T10:00:00Z","tags":["urgent"]`,
+		},
+		{
+			name:       "edit",
+			keys:       []string{"down", "e", "backspace", "backspace", "backspace", "backspace", "t", "e", "s", "t", "s", "enter"},
+			responses:  map[string]string{"PUT /api/v9/workspaces/1/time_entries/2": `{"id":2,"description":"Write tests","project_id":10,"workspace_id":1}`},
+			wantStatus: `Updated "Write tests" [Website]`,
+			wantCall:   "PUT /api/v9/workspaces/1/time_entries/2",
+			wantBody:   `"description":"Write tests"`,
+		},
+		{
+			name:       "escape prompt",
+			keys:       []string{"e", "x", "esc"},
+			wantStatus: "",
+			wantCall:   "",
+		},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{responses: map[string]string{}}
+			for k, v := range tt.responses {
+				api.responses[k] = v
+			}
+			d := newTestDashboard(t, api)
+			api.requests = nil
+			for _, key := range tt.keys {
+				if d.key(key) {
+					t.Fatalf("Expected the dashboard to keep running after %q", key)
+				}
+				if d.busy != "" {
+					d.finish(<-d.results)
+				}
+			}
+			if d.status != tt.wantStatus {
+				t.Errorf("Expected status %q, got %q", tt.wantStatus, d.status)
+			}
+			if tt.wantCall == "" {
+				for _, r := range api.requests {
+					if !strings.HasPrefix(r, "GET ") {
+						t.Errorf("Expected no change, got %v", api.requests)
+					}
+				}
+				return
+			}
+			if !strings.Contains(strings.Join(api.requests, "\n"), tt.wantCall) {
+				t.Errorf("Expected %s in %v", tt.wantCall, api.requests)
+			}
+			if !strings.Contains(string(api.bodies[tt.wantCall]), tt.wantBody) {
+				t.Errorf("Expected %s in %s", tt.wantBody, api.bodies[tt.wantCall])
+			}
+		})
+	}
+}
+
+func TestDashboardBackground(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"PATCH /api/v9/workspaces/1/time_entries/3/stop": `{"id":3,"description":"Review","duration":1800,"workspace_id":1}`,
+		"GET /api/v9/me":                         meResponse,
+		"POST /api/v9/workspaces/1/time_entries": `{"id":4,"description":"Docs","duration":-1,"start":"2024-05-15T10:00:00Z","workspace_id":1}`,
+	}}
+	d := newTestDashboard(t, api)
+	d.key("x")
+	if d.busy != "Stopping" {
+		t.Fatalf("Expected the stop to run in the background, got %q", d.busy)
+	}
+	if screen := d.render(); !strings.Contains(screen, "Stopping…") {
+		t.Errorf("Expected the status of the stop in\n%s", screen)
+	}
+	// Other actions and prompts wait for it instead of being lost.
+	d.key("s")
+	for _, key := range []string{"D", "o", "c", "s", "enter"} {
+		d.key(key)
+	}
+	if screen := d.render(); !strings.Contains(screen, "Stopping… (1 queued)") {
+		t.Errorf("Expected the queued start in\n%s", screen)
+	}
+	d.finish(<-d.results)
+	if d.busy != "Saving" {
+		t.Fatalf("Expected the queued start to run, got %q", d.busy)
+	}
+	d.finish(<-d.results)
+	if d.busy != "" || !strings.Contains(string(api.bodies["POST /api/v9/workspaces/1/time_entries"]), `"description":"Docs"`) {
+		t.Errorf("unexpected state after the start: %q, %s", d.busy, api.bodies["POST /api/v9/workspaces/1/time_entries"])
+	}
+
+	// Errors of the fetch after the action are shown too.
+	api.responses["GET /api/v9/me/time_entries/current"] = `invalid`
+	d.key("x")
+	d.finish(<-d.results)
+	if !strings.HasPrefix(d.status, "Error: ") {
+		t.Errorf("Expected an error, got %q", d.status)
+	}
+}
+
+func TestDashboardRefreshDelay(t *testing.T) {
+	d := newTestDashboard(t, &fakeAPI{responses: map[string]string{}})
+	want := []time.Duration{dashboardRefresh, 5 * time.Second, 10 * time.Second, 20 * time.Second}
+	for _, w := range want {
+		if got := d.refreshDelay(); got != w {
+			t.Errorf("Expected %v after %d failures, got %v", w, d.failures, got)
+		}
+		d.failures++
+	}
+	d.failures = 100
+	if got := d.refreshDelay(); got != dashboardMaxRetry {
+		t.Errorf("Expected %v, got %v", dashboardMaxRetry, got)
+	}
+}
+
+func TestDashboardPrintable(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{}}
+	d := newTestDashboard(t, api)
+	d.entries[1].Description = "Write\x1b[2J\x1b]0;title\x07 docs\nnow"
+	d.entries[1].ProjectName = "Web\x1bsite"
+	d.status = "Deleted \x1b[31mall"
+	screen := d.render()
+	for _, bad := range []string{"\x1b[2J", "\x1b]0", "\x07", "\x1b[31m", "\x1bsite"} {
+		if strings.Contains(screen, bad) {
+			t.Errorf("Expected no %q in the screen", bad)
+		}
+	}
+	for _, want := range []string{"Write?[2J?]0;title? docs now #docs", "Web?site", "Deleted ?[31mall"} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("Expected %q in\n%s", want, escapes.ReplaceAllString(screen, ""))
+		}
+	}
+}
+
+func TestDashboardQuit(t *testing.T) {
+	for _, key := range []string{"q", "esc", "ctrl-c"} {
+		d := newTestDashboard(t, &fakeAPI{responses: map[string]string{}})
+		if !d.key(key) {
+			t.Errorf("Expected %q to quit", key)
+		}
+	}
+}
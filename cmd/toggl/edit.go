@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// entryView is the editable view of a time entry.
+type entryView struct {
+	Description string   `json:"description"`
+	Project     string   `json:"project"` // Project name or ID
+	Tags        []string `json:"tags"`
+	Start       string   `json:"start"` // RFC3339
+	Stop        string   `json:"stop"`  // RFC3339, empty while running
+}
+
+func setupEdit(fs *flag.FlagSet) func(e *env, args []string) error {
+	var (
+		description         string
+		project             string
+		addTags, removeTags stringList
+		start, stop         string
+		editor              bool
+		dryRun              bool
+	)
+	fs.StringVar(&description, "description", "", "set the `description`")
+	fs.StringVar(&description, "d", "", "shorthand for -description")
+	fs.StringVar(&project, "project", "", "move to the project with this `name or ID`")
+	fs.StringVar(&project, "p", "", "shorthand for -project")
+	fs.Var(&addTags, "add-tag", "add comma separated tag `names`, may be repeated")
+	fs.Var(&removeTags, "remove-tag", "remove comma separated tag `names`, may be repeated")
+	fs.StringVar(&start, "start", "", "shift the start by a `duration` like +15m or -1h, or set it to HH:MM or RFC3339")
+	fs.StringVar(&stop, "stop", "", "shift the stop by a `duration` like +15m or -1h, or set it to HH:MM or RFC3339")
+	fs.BoolVar(&editor, "editor", false, "edit the time entry as JSON in $EDITOR, YAML is not supported")
+	fs.BoolVar(&editor, "e", false, "shorthand for -editor")
+	fs.BoolVar(&dryRun, "dry-run", false, "only show the changes")
+	fs.BoolVar(&dryRun, "n", false, "shorthand for -dry-run")
+	return func(e *env, args []string) error {
+		if len(args) > 1 {
+			return errUsage
+		}
+		te, err := editTarget(e, args)
+		if err != nil {
+			return err
+		}
+		before, err := viewOf(e, te)
+		if err != nil {
+			return err
+		}
+
+		after := before
+		after.Tags = slices.Clone(before.Tags)
+		if description != "" {
+			after.Description = description
+		}
+		if project != "" {
+			after.Project = project
+		}
+		for _, tag := range addTags {
+			if !slices.Contains(after.Tags, tag) {
+				after.Tags = append(after.Tags, tag)
+			}
+		}
+		after.Tags = slices.DeleteFunc(after.Tags, func(tag string) bool { return slices.Contains(removeTags, tag) })
+		if start != "" {
+			if after.Start, err = adjustTime(after.Start, start, e.location); err != nil {
+				return fmt.Errorf("invalid -start: %w", err)
+			}
+		}
+		if stop != "" {
+			if after.Stop == "" {
+				return errors.New("the time entry is running, it has no stop to change")
+			}
+			if after.Stop, err = adjustTime(after.Stop, stop, e.location); err != nil {
+				return fmt.Errorf("invalid -stop: %w", err)
+			}
+		}
+		if editor {
+			if after, err = editView(e, after); err != nil {
+				return err
+			}
+		}
+
+		changes := diffViews(before, after)
+		if len(changes) == 0 {
+			fmt.Fprintln(e.stdout, "No changes")
+			return nil
+		}
+		for _, line := range changes {
+			fmt.Fprintln(e.stdout, line)
+		}
+		if dryRun {
+			return nil
+		}
+
+		body, err := updateBody(e, te, before, after)
+		if err != nil {
+			return err
+		}
+		c, err := e.togglClient()
+		if err != nil {
+			return err
+		}
+		updated, err := c.TimeEntriesClient.PutTimeEntries(timeentries.PutTimeEntriesInput{
+			WorkspaceId: te.WorkspaceId,
+			TimeEntryId: te.Id,
+			Body:        body,
+		})
+		if err != nil {
+			return err
+		}
+		if updated.Id == 0 {
+			return fmt.Errorf("time entry %d not found", te.Id)
+		}
+		fmt.Fprintf(e.stdout, "Updated %s\n", describe(updated, e.projectName(updated.ProjectId)))
+		return nil
+	}
+}
+
+// editTarget returns the time entry with the ID in args, or the running time entry.
+func editTarget(e *env, args []string) (timeentries.GetTimeEntriesOutput, error) {
+	c, err := e.togglClient()
+	if err != nil {
+		return timeentries.GetTimeEntriesOutput{}, err
+	}
+	if len(args) == 0 {
+		te, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+		if err != nil {
+			return timeentries.GetTimeEntriesOutput{}, err
+		}
+		if te.Id == 0 {
+			return timeentries.GetTimeEntriesOutput{}, errors.New("no time entry is running, give the ID of the time entry to edit")
+		}
+		return te, nil
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return timeentries.GetTimeEntriesOutput{}, errUsage
+	}
+	te, err := c.TimeEntriesClient.GetATimeEntryById(timeentries.GetATimeEntryByIdInput{TimeEntryId: id})
+	if err != nil {
+		return timeentries.GetTimeEntriesOutput{}, err
+	}
+	if te.Id == 0 {
+		return timeentries.GetTimeEntriesOutput{}, fmt.Errorf("time entry %d not found", id)
+	}
+	return te, nil
+}
+
+// viewOf returns the editable view of the time entry, with times in the timezone of e.
+func viewOf(e *env, te timeentries.GetTimeEntriesOutput) (entryView, error) {
+	start, err := entryStart(te, e.location)
+	if err != nil {
+		return entryView{}, err
+	}
+	v := entryView{
+		Description: te.Description,
+		Project:     entryProject(e, te),
+		Tags:        slices.Clone(te.Tags),
+		Start:       start.Format(time.RFC3339),
+	}
+	if v.Project == "" && te.ProjectId != 0 {
+		v.Project = strconv.Itoa(te.ProjectId)
+	}
+	if v.Tags == nil {
+		v.Tags = []string{}
+	}
+	if te.Duration >= 0 {
+		v.Stop = start.Add(time.Duration(te.Duration) * time.Second).Format(time.RFC3339)
+	}
+	return v, nil
+}
+
+// adjustTime shifts the RFC3339 time t by a signed duration like "+15m",
+// or replaces it with an RFC3339 time or a time of day like "09:30" on the same day in loc.
+func adjustTime(t, value string, loc *time.Location) (string, error) {
+	base, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return "", err
+	}
+	base = base.In(loc)
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return "", err
+		}
+		return base.Add(d).Format(time.RFC3339), nil
+	}
+	if clock, err := time.Parse("15:04", value); err == nil {
+		return time.Date(base.Year(), base.Month(), base.Day(), clock.Hour(), clock.Minute(), 0, 0, loc).Format(time.RFC3339), nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return "", fmt.Errorf("%q is neither a duration like +15m, HH:MM nor RFC3339", value)
+	}
+	return value, nil
+}
+
+// editView opens the view as JSON in the editor of the user and returns the edited view.
+func editView(e *env, v entryView) (entryView, error) {
+	dir, err := os.MkdirTemp("", "toggl-edit")
+	if err != nil {
+		return entryView{}, err
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "entry.json")
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return entryView{}, err
+	}
+	if err := os.WriteFile(path, append(b, '\n'), 0o600); err != nil {
+		return entryView{}, err
+	}
+
+	editor := strings.Fields(e.getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+	cmd := exec.Command(editor[0], append(editor[1:], path)...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = e.stdin, e.stdout, e.stderr
+	if err := cmd.Run(); err != nil {
+		return entryView{}, fmt.Errorf("editor: %w", err)
+	}
+
+	b, err = os.ReadFile(path)
+	if err != nil {
+		return entryView{}, err
+	}
+	edited := entryView{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&edited); err != nil {
+		return entryView{}, fmt.Errorf("invalid time entry: %w", err)
+	}
+	if edited.Tags == nil {
+		edited.Tags = []string{}
+	}
+	return edited, nil
+}
+
+// diffViews returns the changed fields, as removed and added lines.
+func diffViews(before, after entryView) []string {
+	var lines []string
+	field := func(name, old, new string) {
+		if old != new {
+			lines = append(lines, fmt.Sprintf("- %s: %s", name, old), fmt.Sprintf("+ %s: %s", name, new))
+		}
+	}
+	field("description", before.Description, after.Description)
+	field("project", before.Project, after.Project)
+	field("tags", strings.Join(before.Tags, ", "), strings.Join(after.Tags, ", "))
+	field("start", before.Start, after.Start)
+	field("stop", before.Stop, after.Stop)
+	return lines
+}
+
+// updateBody returns the body updating the changed fields of the time entry.
+func updateBody(e *env, te timeentries.GetTimeEntriesOutput, before, after entryView) (timeentries.PutTimeEntriesBody, error) {
+	body := timeentries.PutTimeEntriesBody{WorkspaceId: te.WorkspaceId}
+	if after.Description != before.Description {
+		if after.Description == "" {
+			return body, errors.New("the description cannot be cleared")
+		}
+		body.Description = after.Description
+	}
+	if after.Project != before.Project {
+		if after.Project == "" {
+			return body, errors.New("the project cannot be removed")
+		}
+		id, err := e.resolveProject(te.WorkspaceId, after.Project)
+		if err != nil {
+			return body, err
+		}
+		body.ProjectId = id
+	}
+	if !slices.Equal(after.Tags, before.Tags) {
+		if len(after.Tags) == 0 {
+			body.TagAction = "delete"
+			body.Tags = before.Tags
+		} else {
+			body.Tags = after.Tags
+		}
+	}
+	if after.Start != before.Start || after.Stop != before.Stop {
+		if (before.Stop == "") != (after.Stop == "") {
+			return body, errors.New("a time entry cannot be stopped or restarted by editing, use stop or continue")
+		}
+		start, err := time.Parse(time.RFC3339, after.Start)
+		if err != nil {
+			return body, fmt.Errorf("invalid start: %w", err)
+		}
+		s := start.UTC().Format(time.RFC3339)
+		body.Start = &s
+		// A running time entry keeps running with a negative duration, as the API asks.
+		body.Duration = -1
+		if after.Stop != "" {
+			stop, err := time.Parse(time.RFC3339, after.Stop)
+			if err != nil {
+				return body, fmt.Errorf("invalid stop: %w", err)
+			}
+			if !stop.After(start) {
+				return body, errors.New("the stop must be after the start")
+			}
+			body.Stop = stop.UTC().Format(time.RFC3339)
+			body.Duration = int(stop.Sub(start).Seconds())
+		}
+	}
+	return body, nil
+}
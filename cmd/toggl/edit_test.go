@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/google/go-cmp/cmp"
+)
+
+const editEntryResponse = `{"id":2,"description":"Write dcos","project_id":10,"tags":["docs","web"],"duration":1800,"start":"2024-05-15T08:00:00+00:00","workspace_id":1}`
+
+func TestAdjustTime(t *testing.T) {
+	const base = "2024-05-15T08:00:00Z"
+	test := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "+15m", want: "2024-05-15T08:15:00Z"},
+		{value: "-1h30m", want: "2024-05-15T06:30:00Z"},
+		{value: "09:45", want: "2024-05-15T09:45:00Z"},
+		{value: "2024-05-14T10:00:00+09:00", want: "2024-05-14T10:00:00+09:00"},
+		{value: "+15", wantErr: true},
+		{value: "tomorrow", wantErr: true},
+	}
+	for _, tt := range test {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := adjustTime(base, tt.value, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEdit(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/2":           editEntryResponse,
+		"GET /api/v9/me/projects":                 projectsResponse,
+		"PUT /api/v9/workspaces/1/time_entries/2": `{"id":2,"description":"Write docs","project_id":10,"tags":["docs","review"],"duration":2700,"workspace_id":1}`,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+
+	args := []string{"edit", "2", "-d", "Write docs", "-add-tag", "review", "-remove-tag", "web", "-start", "-15m"}
+	if code := run(args, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	want := "- description: Write dcos\n+ description: Write docs\n" +
+		"- tags: docs, web\n+ tags: docs, review\n" +
+		"- start: 2024-05-15T08:00:00Z\n+ start: 2024-05-15T07:45:00Z\n" +
+		"Updated \"Write docs\" [Website] #docs #review\n"
+	if stdout.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, stdout.String())
+	}
+
+	got := timeentries.PutTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["PUT /api/v9/workspaces/1/time_entries/2"], &got); err != nil {
+		t.Fatal(err)
+	}
+	start := "2024-05-15T07:45:00Z"
+	wantBody := timeentries.PutTimeEntriesBody{
+		Description: "Write docs",
+		Duration:    2700,
+		Start:       &start,
+		Stop:        "2024-05-15T08:30:00Z",
+		Tags:        []string{"docs", "review"},
+		WorkspaceId: 1,
+	}
+	if !cmp.Equal(wantBody, got) {
+		t.Errorf("diff: %v", cmp.Diff(wantBody, got))
+	}
+}
+
+func TestEditNoChange(t *testing.T) {
+	test := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"no changes", []string{"edit", "2", "-d", "Write dcos"}, "No changes\n"},
+		{"dry run", []string{"edit", "2", "-n", "-remove-tag", "docs,web"}, "- tags: docs, web\n+ tags: \n"},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{responses: map[string]string{
+				"GET /api/v9/me/time_entries/2": editEntryResponse,
+				"GET /api/v9/me/projects":       projectsResponse,
+			}}
+			e, stdout, stderr := newTestEnv(api)
+			if code := run(tt.args, e); code != 0 {
+				t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, stdout.String())
+			}
+			if _, ok := api.bodies["PUT /api/v9/workspaces/1/time_entries/2"]; ok {
+				t.Error("Expected no update")
+			}
+		})
+	}
+}
+
+func TestEditRemoveAllTags(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/2":           editEntryResponse,
+		"GET /api/v9/me/projects":                 projectsResponse,
+		"PUT /api/v9/workspaces/1/time_entries/2": `{"id":2,"description":"Write dcos","workspace_id":1}`,
+	}}
+	e, _, stderr := newTestEnv(api)
+	if code := run([]string{"edit", "2", "-remove-tag", "docs,web"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	got := timeentries.PutTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["PUT /api/v9/workspaces/1/time_entries/2"], &got); err != nil {
+		t.Fatal(err)
+	}
+	want := timeentries.PutTimeEntriesBody{TagAction: "delete", Tags: []string{"docs", "web"}, WorkspaceId: 1}
+	if !cmp.Equal(want, got) {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+}
+
+func TestEditEditor(t *testing.T) {
+	dir := t.TempDir()
+	editor := filepath.Join(dir, "editor")
+	script := "#!/bin/sh\nsed -i 's/Write dcos/Write docs/; s/\"Website\"/\"20\"/' \"$1\"\n"
+	if err := os.WriteFile(editor, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/2":           editEntryResponse,
+		"GET /api/v9/me/projects":                 projectsResponse,
+		"PUT /api/v9/workspaces/1/time_entries/2": `{"id":2,"description":"Write docs","project_id":20,"workspace_id":1}`,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+	e.getenv = func(key string) string {
+		if key == "EDITOR" {
+			return editor
+		}
+		return ""
+	}
+	if code := run([]string{"edit", "-e", "2"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	want := "- description: Write dcos\n+ description: Write docs\n" +
+		"- project: Website\n+ project: 20\n" +
+		"Updated \"Write docs\" [Website]\n"
+	if stdout.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, stdout.String())
+	}
+	got := timeentries.PutTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["PUT /api/v9/workspaces/1/time_entries/2"], &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Description != "Write docs" || got.ProjectId != 20 {
+		t.Errorf("unexpected body: %+v", got)
+	}
+}
+
+func TestEditRunningStart(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current":     `{"id":3,"description":"Review","duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1}`,
+		"PUT /api/v9/workspaces/1/time_entries/3": `{"id":3,"description":"Review","duration":-1,"start":"2024-05-15T09:15:00+00:00","workspace_id":1}`,
+	}}
+	e, _, stderr := newTestEnv(api)
+	if code := run([]string{"edit", "-start", "-15m"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	got := timeentries.PutTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["PUT /api/v9/workspaces/1/time_entries/3"], &got); err != nil {
+		t.Fatal(err)
+	}
+	// The time entry keeps running from the new start.
+	start := "2024-05-15T09:15:00Z"
+	wantBody := timeentries.PutTimeEntriesBody{Duration: -1, Start: &start, WorkspaceId: 1}
+	if !cmp.Equal(wantBody, got) {
+		t.Errorf("diff: %v", cmp.Diff(wantBody, got))
+	}
+}
+
+func TestEditRunningStop(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current": `{"id":3,"duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1}`,
+	}}
+	e, _, stderr := newTestEnv(api)
+	if code := run([]string{"edit", "-stop", "+5m"}, e); code != 1 {
+		t.Fatalf("Expected exit code 1, got %d", code)
+	}
+	if want := "toggl edit: the time entry is running, it has no stop to change\n"; stderr.String() != want {
+		t.Errorf("Expected %q, got %q", want, stderr.String())
+	}
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/toggl"
+)
+
+// createdWith identifies the CLI in the time entries it creates.
+const createdWith = "toggl-go"
+
+// env holds the input, outputs and lazily created Toggl client of a command.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	now    func() time.Time
+	getenv func(string) string
+	// location is the timezone times are shown and dates are interpreted in.
+	location *time.Location
+
+	config      *config
+	profileName string
+	profile     *profile // Selected profile, nil if none
+
+	client   *toggl.Client
+	me       *me.GetMeOutput
+	projects []me.GetProjectsOutput
+}
+
+func newEnv(stdin io.Reader, stdout, stderr io.Writer) *env {
+	return &env{
+		stdin:    stdin,
+		stdout:   stdout,
+		stderr:   stderr,
+		now:      time.Now,
+		getenv:   os.Getenv,
+		location: time.Local,
+	}
+}
+
+// loadConfig returns the configuration, read once.
+func (e *env) loadConfig() (*config, error) {
+	if e.config != nil {
+		return e.config, nil
+	}
+	c, err := loadConfig(configPath(e.getenv))
+	if err != nil {
+		return nil, err
+	}
+	e.config = c
+	return c, nil
+}
+
+// useProfile selects the profile given by name, $TOGGL_PROFILE or the current profile of the
+// configuration. Without any of them, no profile is used.
+func (e *env) useProfile(name string) error {
+	c, err := e.loadConfig()
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = e.getenv("TOGGL_PROFILE")
+	}
+	if name == "" {
+		name = c.Current
+	}
+	if name == "" {
+		return nil
+	}
+	if err := validProfileName(name); err != nil {
+		return err
+	}
+	p, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("unknown profile %q", name)
+	}
+	if p.Timezone != "" {
+		loc, err := time.LoadLocation(p.Timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone %q in profile %q", p.Timezone, name)
+		}
+		e.location = loc
+	}
+	e.profileName, e.profile = name, p
+	return nil
+}
+
+// togglClient returns the Toggl client, creating it on first use with the API token of the profile,
+// or from the TOKEN environment variable without a profile.
+func (e *env) togglClient() (toggl.Client, error) {
+	if e.client != nil {
+		return *e.client, nil
+	}
+	var token string
+	if e.profile != nil {
+		var err error
+		if token, err = e.profile.token(e); err != nil {
+			return toggl.Client{}, fmt.Errorf("profile %q: %w", e.profileName, err)
+		}
+	} else if token = e.getenv("TOKEN"); token == "" {
+		return toggl.Client{}, errors.New(`no API token, set the TOKEN environment variable or add a profile with "toggl profile add"`)
+	}
+	c := toggl.NewClient(token)
+	e.client = &c
+	return c, nil
+}
+
+// currentUser returns the profile of the current user, fetched once.
+func (e *env) currentUser() (me.GetMeOutput, error) {
+	if e.me != nil {
+		return *e.me, nil
+	}
+	c, err := e.togglClient()
+	if err != nil {
+		return me.GetMeOutput{}, err
+	}
+	u, err := c.MeClient.GetMe(me.GetMeInput{})
+	if err != nil {
+		return me.GetMeOutput{}, err
+	}
+	e.me = &u
+	return u, nil
+}
+
+// workspaceId returns id, or if id is zero the default workspace of the profile or of the current user.
+func (e *env) workspaceId(id int) (int, error) {
+	if id != 0 {
+		return id, nil
+	}
+	if e.profile != nil && e.profile.Workspace != 0 {
+		return e.profile.Workspace, nil
+	}
+	u, err := e.currentUser()
+	if err != nil {
+		return 0, err
+	}
+	if u.DefaultWorkspaceId == 0 {
+		return 0, errors.New("no default workspace, use -workspace")
+	}
+	return u.DefaultWorkspaceId, nil
+}
+
+// allProjects returns the projects of the current user, fetched once.
+func (e *env) allProjects() ([]me.GetProjectsOutput, error) {
+	if e.projects != nil {
+		return e.projects, nil
+	}
+	c, err := e.togglClient()
+	if err != nil {
+		return nil, err
+	}
+	projects, err := c.MeClient.GetProjects(me.GetProjectsInput{})
+	if err != nil {
+		return nil, err
+	}
+	e.projects = projects
+	return projects, nil
+}
+
+// resolveProject returns the ID of the project given by ID or by name in the workspace.
+// An empty value resolves to no project. Aliases of the profile are expanded.
+func (e *env) resolveProject(workspaceId int, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	value = e.projectAlias(value)
+	if id, err := strconv.Atoi(value); err == nil {
+		return id, nil
+	}
+	projects, err := e.allProjects()
+	if err != nil {
+		return 0, err
+	}
+	for _, p := range projects {
+		if p.WorkspaceId == workspaceId && strings.EqualFold(p.Name, value) {
+			return p.Id, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown project %q", value)
+}
+
+// projectAlias returns the project name or ID the alias stands for in the profile,
+// or value itself if it is no alias.
+func (e *env) projectAlias(value string) string {
+	if e.profile != nil {
+		if project, ok := e.profile.Aliases[value]; ok {
+			return project
+		}
+	}
+	return value
+}
+
+// outputFormat returns the output format of the profile, table by default.
+func (e *env) outputFormat() string {
+	if e.profile != nil && e.profile.Format != "" {
+		return e.profile.Format
+	}
+	return "table"
+}
+
+// projectName returns the name of the project, or an empty string if it is unknown.
+func (e *env) projectName(id int) string {
+	if id == 0 {
+		return ""
+	}
+	projects, err := e.allProjects()
+	if err != nil {
+		return ""
+	}
+	for _, p := range projects {
+		if p.Id == id {
+			return p.Name
+		}
+	}
+	return ""
+}
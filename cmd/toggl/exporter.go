@@ -0,0 +1,390 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// maxRetries is how many times a request is retried after a rate limit or server error.
+const maxRetries = 3
+
+// retryBackoff is the wait before the first retry, doubled for each further one.
+const retryBackoff = time.Second
+
+// maxRetryWait is the longest a request waits in total before its retries, so that a fetch
+// ends within the default scrape timeout of Prometheus, 10s. A longer Retry-After is not waited for.
+const maxRetryWait = 8 * time.Second
+
+// defaultScrapeTimeout is how long a scrape waits for a fetch if Prometheus does not say.
+const defaultScrapeTimeout = 10 * time.Second
+
+// httpDoer is the HTTP client of the resource clients.
+type httpDoer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// apiMetrics counts the requests sent to Toggl.
+type apiMetrics struct {
+	mu       sync.Mutex
+	requests map[string]int // By method
+	errors   map[string]int // By status code, or "transport" if there was no response
+	retries  int
+}
+
+func newAPIMetrics() *apiMetrics {
+	return &apiMetrics{requests: map[string]int{}, errors: map[string]int{}}
+}
+
+// retryClient sends the requests with next, counting them in metrics and retrying
+// rate limited and failed requests up to maxRetries times and maxRetryWait in total.
+type retryClient struct {
+	next    httpDoer
+	metrics *apiMetrics
+	sleep   func(time.Duration)
+}
+
+func (c *retryClient) Do(r *http.Request) (*http.Response, error) {
+	// The body is read once, so that it can be sent again.
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if body, err = io.ReadAll(r.Body); err != nil {
+			return nil, err
+		}
+		r.Body.Close()
+	}
+	backoff, waited := retryBackoff, time.Duration(0)
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		c.metrics.mu.Lock()
+		c.metrics.requests[r.Method]++
+		if attempt > 0 {
+			c.metrics.retries++
+		}
+		c.metrics.mu.Unlock()
+
+		resp, err := c.next.Do(r)
+		status := "transport"
+		if err == nil {
+			if resp.StatusCode < 400 {
+				return resp, nil
+			}
+			status = strconv.Itoa(resp.StatusCode)
+		}
+		c.metrics.mu.Lock()
+		c.metrics.errors[status]++
+		c.metrics.mu.Unlock()
+
+		retry := err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		if !retry || attempt == maxRetries {
+			return resp, err
+		}
+		wait := backoff
+		if err == nil {
+			if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+				wait = time.Duration(seconds) * time.Second
+			}
+		}
+		if waited+wait > maxRetryWait {
+			return resp, err
+		}
+		if err == nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		c.sleep(wait)
+		waited += wait
+		backoff *= 2
+	}
+}
+
+// metric is a metric family in the Prometheus text format.
+type metric struct {
+	name    string
+	help    string
+	kind    string // gauge or counter
+	samples []sample
+}
+
+type sample struct {
+	labels [][2]string
+	value  float64
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// writeMetrics writes the metrics in the Prometheus text format.
+func writeMetrics(w io.Writer, metrics []metric) error {
+	var b strings.Builder
+	for _, m := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.kind)
+		for _, s := range m.samples {
+			b.WriteString(m.name)
+			if len(s.labels) > 0 {
+				labels := make([]string, 0, len(s.labels))
+				for _, l := range s.labels {
+					labels = append(labels, l[0]+`="`+labelEscaper.Replace(l[1])+`"`)
+				}
+				b.WriteString("{" + strings.Join(labels, ",") + "}")
+			}
+			b.WriteString(" " + strconv.FormatFloat(s.value, 'g', -1, 64) + "\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// exporter serves the tracked time and the API metrics on /metrics.
+type exporter struct {
+	e        *env
+	interval time.Duration
+	metrics  *apiMetrics
+
+	mu sync.Mutex // Guards the fetched data, never held while fetching
+	exporterData
+	up       bool
+	fetching chan struct{} // Closed when the running fetch ends, nil if none
+}
+
+// exporterData is what the exporter fetches from Toggl.
+type exporterData struct {
+	running  timeentries.GetTimeEntriesOutput
+	entries  []timeentries.GetTimeEntriesOutput
+	projects map[int]string // Project names by ID
+	fetched  time.Time
+}
+
+// newExporter returns an exporter whose client counts and retries the requests to Toggl.
+func newExporter(e *env, interval time.Duration) (*exporter, error) {
+	c, err := e.togglClient()
+	if err != nil {
+		return nil, err
+	}
+	x := &exporter{e: e, interval: interval, metrics: newAPIMetrics()}
+	c.TimeEntriesClient.HttpClient = &retryClient{next: c.TimeEntriesClient.HttpClient, metrics: x.metrics, sleep: time.Sleep}
+	c.MeClient.HttpClient = &retryClient{next: c.MeClient.HttpClient, metrics: x.metrics, sleep: time.Sleep}
+	e.client = &c
+	return x, nil
+}
+
+func setupExporter(fs *flag.FlagSet) func(e *env, args []string) error {
+	var (
+		listen   string
+		interval time.Duration
+	)
+	fs.StringVar(&listen, "listen", "localhost:9651", "`address` to serve /metrics on")
+	fs.DurationVar(&interval, "interval", time.Minute, "fetch the time entries from Toggl at most this often")
+	return func(e *env, args []string) error {
+		if len(args) > 0 {
+			return errUsage
+		}
+		if interval <= 0 {
+			return errors.New("the interval must be positive")
+		}
+		x, err := newExporter(e, interval)
+		if err != nil {
+			return err
+		}
+		mux := http.NewServeMux()
+		mux.Handle("GET /metrics", x)
+		server := &http.Server{Addr: listen, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			server.Shutdown(context.Background())
+		}()
+		fmt.Fprintf(e.stdout, "Serving metrics on http://%s/metrics\n", listen)
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// ServeHTTP serves the metrics, fetching the time entries again once they are older than
+// the interval. Fetches run in the background, one at a time. The scrape starting one waits
+// for it up to the scrape timeout, and other scrapes are served the last values meanwhile.
+func (x *exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var wait chan struct{}
+	x.mu.Lock()
+	if x.fetching == nil && (x.fetched.IsZero() || x.e.now().Sub(x.fetched) >= x.interval) {
+		x.fetching = make(chan struct{})
+		wait = x.fetching
+		go x.refresh(x.fetching)
+	}
+	x.mu.Unlock()
+	if wait != nil {
+		timer := time.NewTimer(scrapeTimeout(r))
+		select {
+		case <-wait:
+		case <-timer.C:
+		case <-r.Context().Done():
+		}
+		timer.Stop()
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	writeMetrics(w, x.collect())
+}
+
+// scrapeTimeout returns how long the scrape can wait for a fetch: most of the scrape timeout
+// sent by Prometheus, leaving time to answer, or defaultScrapeTimeout.
+func scrapeTimeout(r *http.Request) time.Duration {
+	seconds, err := strconv.ParseFloat(r.Header.Get("X-Prometheus-Scrape-Timeout-Seconds"), 64)
+	if err != nil || seconds <= 0 {
+		return defaultScrapeTimeout
+	}
+	return time.Duration(seconds*float64(time.Second)) * 9 / 10
+}
+
+// refresh fetches the data and closes done. A failed fetch is tried again on the next
+// scrape, serving the last time entries meanwhile.
+func (x *exporter) refresh(done chan struct{}) {
+	data, err := x.fetch()
+	if err != nil {
+		slog.Error(fmt.Sprintf("Failed to fetch the time entries: %v", err))
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.up = err == nil
+	if x.up {
+		x.exporterData = data
+	}
+	x.fetching = nil
+	close(done)
+}
+
+// fetch fetches the running time entry, the time entries of this week and their project names.
+func (x *exporter) fetch() (exporterData, error) {
+	c, err := x.e.togglClient()
+	if err != nil {
+		return exporterData{}, err
+	}
+	start := weekStart(x.e.now().In(x.e.location))
+	startDate, endDate := start.Format(time.RFC3339), start.AddDate(0, 0, 7).Format(time.RFC3339)
+	entries, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{
+		Query: timeentries.GetTimeEntriesQuery{Meta: true, StartDate: &startDate, EndDate: &endDate},
+	})
+	if err != nil {
+		return exporterData{}, err
+	}
+	running, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+	if err != nil {
+		return exporterData{}, err
+	}
+	projects := map[int]string{}
+	for _, te := range append(entries, running) {
+		if te.ProjectId != 0 {
+			projects[te.ProjectId] = entryProject(x.e, te)
+		}
+	}
+	return exporterData{running: running, entries: entries, projects: projects, fetched: x.e.now()}, nil
+}
+
+// projectName returns the name of the project of the time entry, from the fetched data.
+func (x *exporter) projectName(te timeentries.GetTimeEntriesOutput) string {
+	if te.ProjectName != "" {
+		return te.ProjectName
+	}
+	return x.projects[te.ProjectId]
+}
+
+// collect returns the metrics, counting running time entries until now.
+func (x *exporter) collect() []metric {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	now := x.e.now()
+	up := metric{name: "toggl_up", help: "Whether the last fetch from Toggl succeeded.", kind: "gauge"}
+	up.samples = []sample{{value: 0}}
+	if x.up {
+		up.samples[0].value = 1
+	}
+
+	// The sample has the same labels whether a time entry is running or not, project="" if none.
+	running := metric{name: "toggl_running_timer_seconds", help: "Duration of the running time entry, 0 if none.", kind: "gauge"}
+	running.samples = []sample{{labels: [][2]string{{"project", ""}}}}
+	if x.running.Id != 0 {
+		running.samples[0].labels[0][1] = x.projectName(x.running)
+		running.samples[0].value = elapsed(x.running, now).Seconds()
+	}
+
+	// Time entries count for the day they start on.
+	today := now.In(x.e.location).Format(time.DateOnly)
+	byProject := map[[3]string]float64{}
+	byTag := map[[2]string]float64{}
+	for _, te := range x.entries {
+		start, err := entryStart(te, x.e.location)
+		if err != nil {
+			continue
+		}
+		periods := []string{"week"}
+		if start.Format(time.DateOnly) == today {
+			periods = append(periods, "today")
+		}
+		seconds := elapsed(te, now).Seconds()
+		for _, period := range periods {
+			byProject[[3]string{period, x.projectName(te), strconv.FormatBool(te.Billable)}] += seconds
+			for _, tag := range te.Tags {
+				byTag[[2]string{period, tag}] += seconds
+			}
+		}
+	}
+	tracked := metric{name: "toggl_tracked_seconds", help: "Time tracked today and this week by project and billable.", kind: "gauge"}
+	for _, k := range slices.SortedFunc(maps.Keys(byProject), compareKeys) {
+		tracked.samples = append(tracked.samples, sample{
+			labels: [][2]string{{"period", k[0]}, {"project", k[1]}, {"billable", k[2]}},
+			value:  byProject[k],
+		})
+	}
+	trackedTags := metric{name: "toggl_tracked_tag_seconds", help: "Time tracked today and this week by tag.", kind: "gauge"}
+	for _, k := range slices.SortedFunc(maps.Keys(byTag), compareKeys) {
+		trackedTags.samples = append(trackedTags.samples, sample{
+			labels: [][2]string{{"period", k[0]}, {"tag", k[1]}},
+			value:  byTag[k],
+		})
+	}
+
+	x.metrics.mu.Lock()
+	defer x.metrics.mu.Unlock()
+	requests := metric{name: "toggl_api_requests_total", help: "Requests sent to the Toggl API by method, including retries.", kind: "counter"}
+	for _, method := range slices.Sorted(maps.Keys(x.metrics.requests)) {
+		requests.samples = append(requests.samples, sample{labels: [][2]string{{"method", method}}, value: float64(x.metrics.requests[method])})
+	}
+	apiErrors := metric{name: "toggl_api_errors_total", help: "Failed requests to the Toggl API by status code, transport if there was no response.", kind: "counter"}
+	for _, status := range slices.Sorted(maps.Keys(x.metrics.errors)) {
+		apiErrors.samples = append(apiErrors.samples, sample{labels: [][2]string{{"status", status}}, value: float64(x.metrics.errors[status])})
+	}
+	retries := metric{name: "toggl_api_retries_total", help: "Requests to the Toggl API retried after a rate limit or failure.", kind: "counter"}
+	retries.samples = []sample{{value: float64(x.metrics.retries)}}
+
+	return []metric{up, running, tracked, trackedTags, requests, apiErrors, retries}
+}
+
+func compareKeys[K [2]string | [3]string](a, b K) int {
+	for i := range len(a) {
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
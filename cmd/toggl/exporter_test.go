@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExporterMetrics(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current": `{"id":3,"description":"Review","project_id":10,"duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1}`,
+		"GET /api/v9/me/time_entries":         dashboardEntries,
+		"GET /api/v9/me/projects":             projectsResponse,
+	}}
+	e, _, _ := newTestEnv(api)
+	x, err := newExporter(e, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	x.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	want := `# HELP toggl_up Whether the last fetch from Toggl succeeded.
+# TYPE toggl_up gauge
+toggl_up 1
+# HELP toggl_running_timer_seconds Duration of the running time entry, 0 if none.
+# TYPE toggl_running_timer_seconds gauge
+toggl_running_timer_seconds{project="Website"} 1800
+# HELP toggl_tracked_seconds Time tracked today and this week by project and billable.
+# TYPE toggl_tracked_seconds gauge
+toggl_tracked_seconds{period="today",project="Website",billable="false"} 3600
+toggl_tracked_seconds{period="week",project="",billable="false"} 900
+toggl_tracked_seconds{period="week",project="Website",billable="false"} 3600
+# HELP toggl_tracked_tag_seconds Time tracked today and this week by tag.
+# TYPE toggl_tracked_tag_seconds gauge
+toggl_tracked_tag_seconds{period="today",tag="docs"} 1800
+toggl_tracked_tag_seconds{period="week",tag="docs"} 1800
+# HELP toggl_api_requests_total Requests sent to the Toggl API by method, including retries.
+# TYPE toggl_api_requests_total counter
+toggl_api_requests_total{method="GET"} 3
+# HELP toggl_api_errors_total Failed requests to the Toggl API by status code, transport if there was no response.
+# TYPE toggl_api_errors_total counter
+# HELP toggl_api_retries_total Requests to the Toggl API retried after a rate limit or failure.
+# TYPE toggl_api_retries_total counter
+toggl_api_retries_total 0
+`
+	if got := w.Body.String(); got != want {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+
+	// Scrapes within the interval are served without fetching again.
+	x.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if len(api.requests) != 3 {
+		t.Errorf("expected 3 requests, got %v", api.requests)
+	}
+}
+
+func TestExporterDown(t *testing.T) {
+	e, _, _ := newTestEnv(&fakeAPI{})
+	e.client.TimeEntriesClient.HttpClient = &flakyAPI{statuses: []int{500, 500, 500, 500}}
+	x, err := newExporter(e, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.e.client.TimeEntriesClient.HttpClient.(*retryClient).sleep = func(time.Duration) {}
+	w := httptest.NewRecorder()
+	x.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, want := range []string{"\ntoggl_up 0\n", `toggl_api_errors_total{status="500"} 4`, "\ntoggl_api_retries_total 3\n"} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, w.Body.String())
+		}
+	}
+}
+
+func TestExporterServesDuringFetch(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current": `{"id":3,"description":"Review","project_id":10,"duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1}`,
+		"GET /api/v9/me/time_entries":         dashboardEntries,
+		"GET /api/v9/me/projects":             projectsResponse,
+	}}
+	e, _, _ := newTestEnv(api)
+	x, err := newExporter(e, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	blocking := &blockingAPI{next: api, method: http.MethodGet, started: make(chan struct{}, 2), release: make(chan struct{})}
+	x.e.client.TimeEntriesClient.HttpClient.(*retryClient).next = blocking
+	delete(api.responses, "GET /api/v9/me/time_entries/current")
+	e.now = func() time.Time { return testNow.Add(time.Hour) }
+	old := "\ntoggl_running_timer_seconds{project=\"Website\"} 5400\n"
+
+	// The scrape starting the fetch waits for it up to the scrape timeout.
+	r := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	r.Header.Set("X-Prometheus-Scrape-Timeout-Seconds", "0.01")
+	w := httptest.NewRecorder()
+	x.ServeHTTP(w, r)
+	if !strings.Contains(w.Body.String(), old) {
+		t.Errorf("expected the last values in:\n%s", w.Body)
+	}
+	<-blocking.started
+	x.mu.Lock()
+	done := x.fetching
+	x.mu.Unlock()
+
+	// Other scrapes do not wait for the fetch.
+	w = httptest.NewRecorder()
+	x.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if !strings.Contains(w.Body.String(), old) {
+		t.Errorf("expected the last values in:\n%s", w.Body)
+	}
+
+	close(blocking.release)
+	<-done
+	w = httptest.NewRecorder()
+	x.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if want := "\ntoggl_running_timer_seconds{project=\"\"} 0\n"; !strings.Contains(w.Body.String(), want) {
+		t.Errorf("expected %q in:\n%s", want, w.Body)
+	}
+}
+
+// flakyAPI answers with the statuses in turn, then with 200.
+type flakyAPI struct {
+	statuses []int
+	bodies   []string
+}
+
+func (f *flakyAPI) Do(r *http.Request) (*http.Response, error) {
+	body := ""
+	if r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(b)
+	}
+	f.bodies = append(f.bodies, body)
+	status := http.StatusOK
+	if len(f.statuses) > 0 {
+		status, f.statuses = f.statuses[0], f.statuses[1:]
+	}
+	header := http.Header{}
+	if status == http.StatusTooManyRequests {
+		header.Set("Retry-After", "7")
+	}
+	return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestRetryClient(t *testing.T) {
+	test := []struct {
+		name       string
+		statuses   []int
+		wantStatus int
+		wantWaits  []time.Duration
+		wantErrors map[string]int
+	}{
+		{"success", nil, http.StatusOK, nil, map[string]int{}},
+		{"rate limit", []int{429}, http.StatusOK, []time.Duration{7 * time.Second}, map[string]int{"429": 1}},
+		{"wait exceeded", []int{429, 503}, http.StatusServiceUnavailable, []time.Duration{7 * time.Second}, map[string]int{"429": 1, "503": 1}},
+		{"client error", []int{400}, http.StatusBadRequest, nil, map[string]int{"400": 1}},
+		{"exhausted", []int{500, 500, 500, 500}, http.StatusInternalServerError, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}, map[string]int{"500": 4}},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &flakyAPI{statuses: tt.statuses}
+			var waits []time.Duration
+			c := &retryClient{next: api, metrics: newAPIMetrics(), sleep: func(d time.Duration) { waits = append(waits, d) }}
+			r, err := http.NewRequest(http.MethodPost, "https://api.track.toggl.com/api/v9/x", bytes.NewBufferString("body"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := c.Do(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if !cmp.Equal(tt.wantWaits, waits) {
+				t.Errorf("waits diff: %v", cmp.Diff(tt.wantWaits, waits))
+			}
+			if !cmp.Equal(tt.wantErrors, c.metrics.errors) {
+				t.Errorf("errors diff: %v", cmp.Diff(tt.wantErrors, c.metrics.errors))
+			}
+			if c.metrics.retries != len(tt.wantWaits) || c.metrics.requests["POST"] != len(tt.wantWaits)+1 {
+				t.Errorf("unexpected counts: %d retries, %v requests", c.metrics.retries, c.metrics.requests)
+			}
+			for _, body := range api.bodies {
+				if body != "body" {
+					t.Errorf("expected the body to be sent on every attempt, got %q", body)
+				}
+			}
+		})
+	}
+}
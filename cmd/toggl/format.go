@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// formatElapsed formats a duration as hours, minutes and seconds, e.g. "1:02:03".
+func formatElapsed(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	d = d.Round(time.Second)
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	s := (d % time.Minute) / time.Second
+	return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+}
+
+// entryStart returns the start of the time entry in loc.
+func entryStart(te timeentries.GetTimeEntriesOutput, loc *time.Location) (time.Time, error) {
+	start, err := time.Parse(time.RFC3339, te.Start)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start of time entry %d: %q", te.Id, te.Start)
+	}
+	return start.In(loc), nil
+}
+
+// elapsed returns the duration of the time entry, counting running time entries until now.
+func elapsed(te timeentries.GetTimeEntriesOutput, now time.Time) time.Duration {
+	if te.Duration >= 0 {
+		return time.Duration(te.Duration) * time.Second
+	}
+	start, err := entryStart(te, time.UTC)
+	if err != nil {
+		return 0
+	}
+	return now.Sub(start)
+}
+
+// describe returns a one-line description of the time entry with its project and tags,
+// e.g. `"Write docs" [Website] #writing`.
+func describe(te timeentries.GetTimeEntriesOutput, project string) string {
+	var b strings.Builder
+	if te.Description == "" {
+		b.WriteString("(no description)")
+	} else {
+		fmt.Fprintf(&b, "%q", te.Description)
+	}
+	if project == "" {
+		project = te.ProjectName
+	}
+	if project != "" {
+		fmt.Fprintf(&b, " [%s]", project)
+	}
+	for _, tag := range te.Tags {
+		fmt.Fprintf(&b, " #%s", tag)
+	}
+	if te.Billable {
+		b.WriteString(" $")
+	}
+	return b.String()
+}
@@ -0,0 +1,438 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// gitTrailer is the commit message trailer referring to the running time entry.
+const gitTrailer = "Toggl-Entry"
+
+// gitHookMarker identifies the hooks installed by toggl, so that other hooks are never touched.
+const gitHookMarker = "# Installed by " + createdWith + `, remove with "toggl git uninstall".`
+
+// maxSessionDescription is the maximum length of the description of a session.
+const maxSessionDescription = 200
+
+// gitHookTimeout is how long a hook waits for Toggl, so that it never holds up git for long.
+const gitHookTimeout = 5 * time.Second
+
+// gitHooks are the hooks installed by toggl git install.
+var gitHooks = []string{"prepare-commit-msg"}
+
+// staleGitHooks were installed by earlier versions, they are removed by install and uninstall.
+var staleGitHooks = []string{"post-commit"}
+
+// gitCommit is a commit read from git log.
+type gitCommit struct {
+	Hash    string
+	Time    time.Time
+	Subject string
+}
+
+// gitSession is a run of commits no further apart than the gap.
+type gitSession struct {
+	Start   time.Time
+	Stop    time.Time
+	Commits []gitCommit
+}
+
+func setupGit(fs *flag.FlagSet) func(e *env, args []string) error {
+	var (
+		repo         string
+		since, until string
+		gap, lead    time.Duration
+		author       string
+		description  string
+		f            entryFlags
+		dryRun       bool
+	)
+	fs.StringVar(&repo, "repo", ".", "`directory` of the git repository")
+	fs.StringVar(&since, "since", "", "sessions: from `date`, YYYY-MM-DD or RFC3339")
+	fs.StringVar(&until, "until", "", "sessions: until `date`, YYYY-MM-DD (inclusive) or RFC3339")
+	fs.DurationVar(&gap, "gap", time.Hour, "sessions: start a new session after commits this far apart")
+	fs.DurationVar(&lead, "lead", 15*time.Minute, "sessions: time worked before the first commit of a session")
+	fs.StringVar(&author, "author", "", "sessions: only commits of this `author`, defaults to git config user.email")
+	fs.StringVar(&description, "description", "", "sessions: `description` of the time entries, defaults to the repository and the commit subjects")
+	fs.StringVar(&description, "d", "", "shorthand for -description")
+	f.bind(fs)
+	fs.BoolVar(&dryRun, "dry-run", false, "sessions: show the sessions without creating time entries")
+	fs.BoolVar(&dryRun, "n", false, "shorthand for -dry-run")
+	return func(e *env, args []string) error {
+		if len(args) == 0 {
+			return errUsage
+		}
+		switch action, args := args[0], args[1:]; action {
+		case "install":
+			if len(args) > 0 {
+				return errUsage
+			}
+			return installGitHooks(e, repo)
+		case "uninstall":
+			if len(args) > 0 {
+				return errUsage
+			}
+			return uninstallGitHooks(e, repo)
+		case "prepare-commit-msg":
+			if len(args) == 0 || len(args) > 3 {
+				return errUsage
+			}
+			// Hooks never fail the commit.
+			if err := withHookTimeout(e); err != nil {
+				fmt.Fprintf(e.stderr, "toggl: %v\n", err)
+				return nil
+			}
+			if err := prepareCommitMsg(e, repo, args); err != nil {
+				fmt.Fprintf(e.stderr, "toggl: %v\n", err)
+			}
+			return nil
+		case "post-commit":
+			// The hook installed by earlier versions does nothing until it is removed by install.
+			return nil
+		case "sessions":
+			if len(args) > 1 {
+				return errUsage
+			}
+			if gap <= 0 || lead < 0 {
+				return errors.New("the gap must be positive and the lead not negative")
+			}
+			start, end, err := listRange(args, since, until, e.now().In(e.location))
+			if err != nil {
+				return err
+			}
+			if author == "" {
+				if author, err = gitOutput(repo, "config", "user.email"); err != nil {
+					return errors.New("no author, set git config user.email or use -author")
+				}
+			}
+			commits, err := gitLog(repo, author, start, end)
+			if err != nil {
+				return err
+			}
+			sessions := groupSessions(commits, gap, lead)
+			if dryRun {
+				return writeSessions(e, sessions)
+			}
+			return createSessions(e, repo, sessions, description, f)
+		}
+		return errUsage
+	}
+}
+
+// gitOutput runs git in the repository and returns its trimmed output.
+func gitOutput(repo string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", repo}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// gitHooksDir returns the hooks directory of the repository.
+func gitHooksDir(repo string) (string, error) {
+	dir, err := gitOutput(repo, "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(repo, dir)
+	}
+	return dir, nil
+}
+
+// gitHookScript returns the script of the hook, which does nothing if toggl is not installed.
+func gitHookScript(hook string) string {
+	return "#!/bin/sh\n" + gitHookMarker + "\n" +
+		"command -v toggl >/dev/null 2>&1 || exit 0\n" +
+		"exec toggl git " + hook + " \"$@\"\n"
+}
+
+func installGitHooks(e *env, repo string) error {
+	dir, err := gitHooksDir(repo)
+	if err != nil {
+		return err
+	}
+	// Check every hook first, so that nothing is installed if one is in the way.
+	for _, hook := range gitHooks {
+		b, err := os.ReadFile(filepath.Join(dir, hook))
+		if err == nil && !bytes.Contains(b, []byte(gitHookMarker)) {
+			return fmt.Errorf("%s already exists, add `toggl git %s \"$@\"` to it instead", filepath.Join(dir, hook), hook)
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, hook := range gitHooks {
+		path := filepath.Join(dir, hook)
+		if err := os.WriteFile(path, []byte(gitHookScript(hook)), 0o755); err != nil {
+			return err
+		}
+		// WriteFile keeps the mode of an existing file.
+		if err := os.Chmod(path, 0o755); err != nil {
+			return err
+		}
+		fmt.Fprintf(e.stdout, "Installed %s\n", path)
+	}
+	_, err = removeGitHooks(e, dir, staleGitHooks)
+	return err
+}
+
+func uninstallGitHooks(e *env, repo string) error {
+	dir, err := gitHooksDir(repo)
+	if err != nil {
+		return err
+	}
+	removed, err := removeGitHooks(e, dir, append(slices.Clone(gitHooks), staleGitHooks...))
+	if err != nil {
+		return err
+	}
+	if !removed {
+		fmt.Fprintln(e.stdout, "No toggl hooks installed")
+	}
+	return nil
+}
+
+// removeGitHooks removes the hooks installed by toggl in dir and reports whether there were any.
+func removeGitHooks(e *env, dir string, hooks []string) (bool, error) {
+	removed := false
+	for _, hook := range hooks {
+		path := filepath.Join(dir, hook)
+		b, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) || err == nil && !bytes.Contains(b, []byte(gitHookMarker)) {
+			continue
+		}
+		if err != nil {
+			return removed, err
+		}
+		if err := os.Remove(path); err != nil {
+			return removed, err
+		}
+		fmt.Fprintf(e.stdout, "Removed %s\n", path)
+		removed = true
+	}
+	return removed, nil
+}
+
+// withHookTimeout gives the HTTP clients of the Toggl client gitHookTimeout.
+func withHookTimeout(e *env) error {
+	c, err := e.togglClient()
+	if err != nil {
+		return err
+	}
+	if hc, ok := c.TimeEntriesClient.HttpClient.(*http.Client); ok {
+		withTimeout := *hc
+		withTimeout.Timeout = gitHookTimeout
+		c.TimeEntriesClient.HttpClient = &withTimeout
+	}
+	if hc, ok := c.MeClient.HttpClient.(*http.Client); ok {
+		withTimeout := *hc
+		withTimeout.Timeout = gitHookTimeout
+		c.MeClient.HttpClient = &withTimeout
+	}
+	e.client = &c
+	return nil
+}
+
+// prepareCommitMsg adds the trailer with the running time entry to the commit message file.
+// args are the arguments of the prepare-commit-msg hook: the file, the source and the commit.
+func prepareCommitMsg(e *env, repo string, args []string) error {
+	c, err := e.togglClient()
+	if err != nil {
+		return err
+	}
+	current, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+	if err != nil || current.Id == 0 {
+		return err
+	}
+	b, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	// An amended or reused message may already have the trailer.
+	if bytes.Contains(b, []byte(gitTrailer+":")) {
+		return nil
+	}
+	_, err = gitOutput(repo, "interpret-trailers", "--in-place", "--trailer", gitTrailer+": "+strconv.Itoa(current.Id), args[0])
+	return err
+}
+
+// gitLog returns the commits of the author from start until end, oldest first.
+func gitLog(repo, author string, start, end time.Time) ([]gitCommit, error) {
+	out, err := gitOutput(repo, "log", "--no-merges", "--format=%H%x09%ct%x09%s",
+		"--author="+regexp.QuoteMeta(author),
+		"--since="+strconv.FormatInt(start.Unix(), 10),
+		"--until="+strconv.FormatInt(end.Unix(), 10))
+	if err != nil {
+		return nil, err
+	}
+	var commits []gitCommit
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected git log line %q", line)
+		}
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected git log line %q", line)
+		}
+		commits = append(commits, gitCommit{Hash: fields[0], Time: time.Unix(seconds, 0), Subject: fields[2]})
+	}
+	slices.SortStableFunc(commits, func(a, b gitCommit) int { return a.Time.Compare(b.Time) })
+	return commits, nil
+}
+
+// groupSessions groups the commits, oldest first, into sessions of commits no further apart
+// than gap. A session starts lead before its first commit and stops at its last one.
+func groupSessions(commits []gitCommit, gap, lead time.Duration) []gitSession {
+	var sessions []gitSession
+	for _, commit := range commits {
+		if n := len(sessions); n > 0 && commit.Time.Sub(sessions[n-1].Stop) <= gap {
+			sessions[n-1].Stop = commit.Time
+			sessions[n-1].Commits = append(sessions[n-1].Commits, commit)
+			continue
+		}
+		start := commit.Time.Add(-lead)
+		// Sessions never overlap, even with a lead longer than the gap.
+		if n := len(sessions); n > 0 && start.Before(sessions[n-1].Stop) {
+			start = sessions[n-1].Stop
+		}
+		sessions = append(sessions, gitSession{Start: start, Stop: commit.Time, Commits: []gitCommit{commit}})
+	}
+	return sessions
+}
+
+// sessionDescription returns the name of the repository followed by the commit subjects.
+func sessionDescription(name string, s gitSession) string {
+	subjects := make([]string, 0, len(s.Commits))
+	for _, commit := range s.Commits {
+		subjects = append(subjects, commit.Subject)
+	}
+	description := name + ": " + strings.Join(subjects, "; ")
+	if utf8.RuneCountInString(description) > maxSessionDescription {
+		description = string([]rune(description)[:maxSessionDescription-1]) + "…"
+	}
+	return description
+}
+
+func writeSessions(e *env, sessions []gitSession) error {
+	if len(sessions) == 0 {
+		fmt.Fprintln(e.stdout, "No commits")
+		return nil
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATE\tSTART\tSTOP\tDURATION\tCOMMITS\tFIRST")
+	for _, s := range sessions {
+		start, stop := s.Start.In(e.location), s.Stop.In(e.location)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", start.Format(time.DateOnly), start.Format("15:04"), stop.Format("15:04"),
+			formatElapsed(s.Stop.Sub(s.Start)), len(s.Commits), s.Commits[0].Subject)
+	}
+	return w.Flush()
+}
+
+// createSessions creates a stopped time entry for each session. Sessions without duration or
+// overlapping a time entry, e.g. one created for the session before, are skipped.
+func createSessions(e *env, repo string, sessions []gitSession, description string, f entryFlags) error {
+	if len(sessions) == 0 {
+		fmt.Fprintln(e.stdout, "No commits")
+		return nil
+	}
+	top, err := gitOutput(repo, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return err
+	}
+	c, err := e.togglClient()
+	if err != nil {
+		return err
+	}
+	workspaceId, err := e.workspaceId(f.workspace)
+	if err != nil {
+		return err
+	}
+	projectId, err := e.resolveProject(workspaceId, f.project)
+	if err != nil {
+		return err
+	}
+	// Time entries are listed by their start, so those started the day before are included.
+	startDate := sessions[0].Start.Add(-24 * time.Hour).Format(time.RFC3339)
+	endDate := sessions[len(sessions)-1].Stop.Format(time.RFC3339)
+	existing, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{
+		Query: timeentries.GetTimeEntriesQuery{StartDate: &startDate, EndDate: &endDate},
+	})
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		span := fmt.Sprintf("on %s from %s to %s", s.Start.In(e.location).Format(time.DateOnly), s.Start.In(e.location).Format("15:04"), s.Stop.In(e.location).Format("15:04"))
+		if !s.Stop.After(s.Start) {
+			fmt.Fprintf(e.stdout, "Skipped the session %s without duration\n", span)
+			continue
+		}
+		if te, ok := overlapping(e, existing, s); ok {
+			fmt.Fprintf(e.stdout, "Skipped the session %s overlapping %s\n", span, describe(te, entryProject(e, te)))
+			continue
+		}
+		body := timeentries.PostTimeEntriesBody{
+			Billable:    f.billable,
+			CreatedWith: createdWith,
+			Description: description,
+			Duration:    int(s.Stop.Sub(s.Start).Seconds()),
+			ProjectId:   projectId,
+			Stop:        s.Stop.UTC().Format(time.RFC3339),
+			Tags:        f.tags,
+			WorkspaceId: workspaceId,
+		}
+		if body.Description == "" {
+			body.Description = sessionDescription(filepath.Base(top), s)
+		}
+		start := s.Start.UTC().Format(time.RFC3339)
+		body.Start = &start
+		te, err := c.TimeEntriesClient.PostTimeEntries(timeentries.PostTimeEntriesInput{WorkspaceId: workspaceId, Body: body})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(e.stdout, "Created %s %s\n", describe(te, e.projectName(te.ProjectId)), span)
+	}
+	return nil
+}
+
+// overlapping returns a time entry overlapping the session, counting running ones until now.
+func overlapping(e *env, entries []timeentries.GetTimeEntriesOutput, s gitSession) (timeentries.GetTimeEntriesOutput, bool) {
+	for _, te := range entries {
+		start, err := entryStart(te, time.UTC)
+		if err != nil {
+			continue
+		}
+		if start.Before(s.Stop) && start.Add(elapsed(te, e.now())).After(s.Start) {
+			return te, true
+		}
+	}
+	return timeentries.GetTimeEntriesOutput{}, false
+}
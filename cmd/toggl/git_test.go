@@ -0,0 +1,281 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/google/go-cmp/cmp"
+)
+
+// newTestRepo creates a git repository with commits at the times, returning its directory.
+func newTestRepo(t *testing.T, times ...time.Time) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := filepath.Join(t.TempDir(), "website")
+	git := func(env []string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		// The configuration of the user, e.g. commit signing, must not matter.
+		cmd.Env = append(os.Environ(), append(env, "GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1")...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	git(nil, "init", "-q")
+	git(nil, "config", "user.email", "dev@example.com")
+	git(nil, "config", "user.name", "Dev")
+	for i, at := range times {
+		date := "GIT_COMMITTER_DATE=" + at.Format(time.RFC3339)
+		git([]string{date, "GIT_AUTHOR_DATE=" + at.Format(time.RFC3339)}, "commit", "-q", "--allow-empty", "-m", "Commit "+string(rune('A'+i)))
+	}
+	return dir
+}
+
+func TestGroupSessions(t *testing.T) {
+	at := func(hour, minute int) gitCommit {
+		return gitCommit{Time: time.Date(2024, 5, 15, hour, minute, 0, 0, time.UTC)}
+	}
+	commits := []gitCommit{at(9, 0), at(9, 40), at(10, 30), at(13, 0), at(13, 10)}
+	test := []struct {
+		name      string
+		gap, lead time.Duration
+		want      [][2]gitCommit // First and last commit of each session
+	}{
+		{"hour gap", time.Hour, 15 * time.Minute, [][2]gitCommit{{at(9, 0), at(10, 30)}, {at(13, 0), at(13, 10)}}},
+		{"short gap", 30 * time.Minute, 15 * time.Minute, [][2]gitCommit{{at(9, 0), at(9, 0)}, {at(9, 40), at(9, 40)}, {at(10, 30), at(10, 30)}, {at(13, 0), at(13, 10)}}},
+		{"long gap", 3 * time.Hour, 0, [][2]gitCommit{{at(9, 0), at(13, 10)}}},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got := groupSessions(commits, tt.gap, tt.lead)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d sessions, got %d", len(tt.want), len(got))
+			}
+			for i, s := range got {
+				first, last := s.Commits[0], s.Commits[len(s.Commits)-1]
+				if first != tt.want[i][0] || last != tt.want[i][1] {
+					t.Errorf("session %d: expected commits %v to %v, got %v to %v", i, tt.want[i][0].Time, tt.want[i][1].Time, first.Time, last.Time)
+				}
+				if !s.Start.Equal(first.Time.Add(-tt.lead)) || !s.Stop.Equal(last.Time) {
+					t.Errorf("session %d: unexpected span %v to %v", i, s.Start, s.Stop)
+				}
+			}
+		})
+	}
+
+	// A lead longer than the gap never overlaps the previous session.
+	got := groupSessions(commits[:2], 30*time.Minute, time.Hour)
+	if !got[1].Start.Equal(at(9, 0).Time) {
+		t.Errorf("expected the second session to start at 9:00, got %v", got[1].Start)
+	}
+}
+
+func TestGitLogAuthor(t *testing.T) {
+	dir := newTestRepo(t, time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC))
+	start, end := time.Date(2024, 5, 14, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	// The author is matched literally, not as a pattern.
+	for author, want := range map[string]int{"dev@example.com": 1, "dev@example.co.": 0, ".*": 0} {
+		commits, err := gitLog(dir, author, start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(commits) != want {
+			t.Errorf("%s: expected %d commits, got %d", author, want, len(commits))
+		}
+	}
+}
+
+func TestGitInstall(t *testing.T) {
+	dir := newTestRepo(t)
+	e, stdout, stderr := newTestEnv(&fakeAPI{responses: map[string]string{}})
+
+	hooks := filepath.Join(dir, ".git", "hooks")
+	// The post-commit hook of earlier versions is replaced.
+	if err := os.WriteFile(filepath.Join(hooks, "post-commit"), []byte(gitHookScript("post-commit")), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if code := run([]string{"git", "install", "-repo", dir}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	if _, err := os.Stat(filepath.Join(hooks, "post-commit")); !os.IsNotExist(err) {
+		t.Errorf("expected the post-commit hook to be removed, got %v", err)
+	}
+	for _, hook := range gitHooks {
+		info, err := os.Stat(filepath.Join(hooks, hook))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm()&0o100 == 0 {
+			t.Errorf("expected %s to be executable, got %v", hook, info.Mode())
+		}
+	}
+	// Installing again replaces the hooks of toggl.
+	if code := run([]string{"git", "install", "-repo", dir}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+
+	if code := run([]string{"git", "uninstall", "-repo", dir}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	for _, hook := range gitHooks {
+		if _, err := os.Stat(filepath.Join(hooks, hook)); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed, got %v", hook, err)
+		}
+	}
+	if !strings.HasSuffix(stdout.String(), "Removed "+filepath.Join(hooks, "prepare-commit-msg")+"\n") {
+		t.Errorf("unexpected output: %q", stdout.String())
+	}
+}
+
+func TestGitInstallExistingHook(t *testing.T) {
+	dir := newTestRepo(t)
+	path := filepath.Join(dir, ".git", "hooks", "prepare-commit-msg")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\necho mine\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	e, _, stderr := newTestEnv(&fakeAPI{responses: map[string]string{}})
+	if code := run([]string{"git", "install", "-repo", dir}, e); code != 1 {
+		t.Fatalf("Expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "already exists") {
+		t.Errorf("unexpected error: %q", stderr.String())
+	}
+	if b, _ := os.ReadFile(path); string(b) != "#!/bin/sh\necho mine\n" {
+		t.Errorf("expected the hook to be kept, got %q", b)
+	}
+}
+
+func TestGitPrepareCommitMsg(t *testing.T) {
+	dir := newTestRepo(t)
+	msg := filepath.Join(dir, ".git", "COMMIT_EDITMSG")
+	test := []struct {
+		name    string
+		current string
+		message string
+		want    string
+	}{
+		{"running", `{"id":42,"description":"Fix login","duration":-1}`, "Fix login\n", "Fix login\n\nToggl-Entry: 42\n"},
+		{"not running", ``, "Fix login\n", "Fix login\n"},
+		{"present", `{"id":42,"description":"Fix login","duration":-1}`, "Fix login\n\nToggl-Entry: 41\n", "Fix login\n\nToggl-Entry: 41\n"},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{responses: map[string]string{}}
+			if tt.current != "" {
+				api.responses["GET /api/v9/me/time_entries/current"] = tt.current
+			}
+			e, _, stderr := newTestEnv(api)
+			if err := os.WriteFile(msg, []byte(tt.message), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if code := run([]string{"git", "prepare-commit-msg", "-repo", dir, msg, "message"}, e); code != 0 || stderr.Len() > 0 {
+				t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+			}
+			if b, _ := os.ReadFile(msg); string(b) != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, b)
+			}
+		})
+	}
+}
+
+func TestGitSessions(t *testing.T) {
+	day := time.Date(2024, 5, 14, 0, 0, 0, 0, time.UTC)
+	dir := newTestRepo(t, day.Add(9*time.Hour), day.Add(9*time.Hour+40*time.Minute), day.Add(14*time.Hour))
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me":                         meResponse,
+		"GET /api/v9/me/projects":                projectsResponse,
+		"POST /api/v9/workspaces/1/time_entries": `{"id":5,"description":"website: Commit C","project_id":10,"workspace_id":1}`,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+
+	if code := run([]string{"git", "sessions", "yesterday", "-repo", dir, "-n"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	want := `DATE        START  STOP   DURATION  COMMITS  FIRST
+2024-05-14  08:45  09:40  0:55:00   2        Commit A
+2024-05-14  13:45  14:00  0:15:00   1        Commit C
+`
+	if stdout.String() != want {
+		t.Errorf("diff: %v", cmp.Diff(want, stdout.String()))
+	}
+	if len(api.requests) != 0 {
+		t.Errorf("expected no requests for a dry run, got %v", api.requests)
+	}
+
+	stdout.Reset()
+	if code := run([]string{"git", "sessions", "yesterday", "-repo", dir, "-p", "Website"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	// The fake API records the body of the last session.
+	got := timeentries.PostTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["POST /api/v9/workspaces/1/time_entries"], &got); err != nil {
+		t.Fatal(err)
+	}
+	start := "2024-05-14T13:45:00Z"
+	wantBody := timeentries.PostTimeEntriesBody{
+		CreatedWith: createdWith,
+		Description: "website: Commit C",
+		Duration:    900,
+		ProjectId:   10,
+		Start:       &start,
+		Stop:        "2024-05-14T14:00:00Z",
+		WorkspaceId: 1,
+	}
+	if !cmp.Equal(wantBody, got) {
+		t.Errorf("diff: %v", cmp.Diff(wantBody, got))
+	}
+	if n := strings.Count(stdout.String(), "Created "); n != 2 {
+		t.Errorf("expected 2 time entries, got %d: %s", n, stdout)
+	}
+
+	// Sessions overlapping time entries, like those created before, and without duration are skipped.
+	api.responses["GET /api/v9/me/time_entries"] = `[{"id":5,"description":"website: Commit C","project_id":10,"duration":900,"start":"2024-05-14T13:45:00Z","workspace_id":1}]`
+	stdout.Reset()
+	if code := run([]string{"git", "sessions", "yesterday", "-repo", dir}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	if want := `Skipped the session on 2024-05-14 from 13:45 to 14:00 overlapping "website: Commit C" [Website]`; !strings.Contains(stdout.String(), want) {
+		t.Errorf("expected %q in %s", want, stdout)
+	}
+	stdout.Reset()
+	if code := run([]string{"git", "sessions", "yesterday", "-repo", dir, "-lead", "0"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	want = `Created "website: Commit C" [Website] on 2024-05-14 from 09:00 to 09:40
+Skipped the session on 2024-05-14 from 14:00 to 14:00 without duration
+`
+	if stdout.String() != want {
+		t.Errorf("diff: %v", cmp.Diff(want, stdout.String()))
+	}
+}
+
+func TestWithHookTimeout(t *testing.T) {
+	e := newEnv(strings.NewReader(""), io.Discard, io.Discard)
+	e.getenv = func(key string) string {
+		if key == "TOKEN" {
+			return "token"
+		}
+		return ""
+	}
+	if err := withHookTimeout(e); err != nil {
+		t.Fatal(err)
+	}
+	for _, hc := range []any{e.client.TimeEntriesClient.HttpClient, e.client.MeClient.HttpClient} {
+		if c, ok := hc.(*http.Client); !ok || c.Timeout != gitHookTimeout {
+			t.Errorf("expected a client with a timeout, got %#v", hc)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// ranges are the relative ranges accepted by the list command.
+var ranges = []string{"today", "yesterday", "this-week", "last-week", "this-month", "last-month"}
+
+// formats are the output formats accepted by the list command.
+var formats = []string{"table", "json", "jsonl", "csv"}
+
+func setupList(fs *flag.FlagSet) func(e *env, args []string) error {
+	var (
+		since, until string
+		project      string
+		tags         stringList
+		match        string
+		format       string
+		tmpl         string
+	)
+	fs.StringVar(&since, "since", "", "list time entries starting from `date`, YYYY-MM-DD or RFC3339")
+	fs.StringVar(&until, "until", "", "list time entries starting until `date`, YYYY-MM-DD (inclusive) or RFC3339")
+	fs.StringVar(&project, "project", "", "only list time entries of the project with this `name or ID`")
+	fs.StringVar(&project, "p", "", "shorthand for -project")
+	fs.Var(&tags, "tag", "only list time entries with all of these comma separated tag `names`, may be repeated")
+	fs.Var(&tags, "t", "shorthand for -tag")
+	fs.StringVar(&match, "match", "", "only list time entries whose description matches the `regexp`")
+	fs.StringVar(&format, "format", "", "output `format`: "+strings.Join(formats, ", ")+", defaults to the format of the profile or table")
+	fs.StringVar(&format, "o", "", "shorthand for -format")
+	fs.StringVar(&tmpl, "template", "", "render each time entry with the Go `template` instead, e.g. '{{.Id}} {{.Description}}'")
+	return func(e *env, args []string) error {
+		if len(args) > 1 {
+			return errUsage
+		}
+		if format == "" {
+			format = e.outputFormat()
+		}
+		if !slices.Contains(formats, format) {
+			return fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(formats, ", "))
+		}
+		var t *template.Template
+		if tmpl != "" {
+			var err error
+			if t, err = template.New("entry").Funcs(template.FuncMap{"join": strings.Join}).Parse(tmpl); err != nil {
+				return err
+			}
+		}
+		var re *regexp.Regexp
+		if match != "" {
+			var err error
+			if re, err = regexp.Compile(match); err != nil {
+				return err
+			}
+		}
+
+		start, end, err := listRange(args, since, until, e.now().In(e.location))
+		if err != nil {
+			return err
+		}
+		c, err := e.togglClient()
+		if err != nil {
+			return err
+		}
+		startDate, endDate := start.Format(time.RFC3339), end.Format(time.RFC3339)
+		entries, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{
+			Query: timeentries.GetTimeEntriesQuery{
+				Meta:      true,
+				StartDate: &startDate,
+				EndDate:   &endDate,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		project := e.projectAlias(project)
+		filtered := make([]timeentries.GetTimeEntriesOutput, 0, len(entries))
+		for _, te := range entries {
+			if project != "" && !matchesProject(e, te, project) {
+				continue
+			}
+			if !hasTags(te, tags) {
+				continue
+			}
+			if re != nil && !re.MatchString(te.Description) {
+				continue
+			}
+			filtered = append(filtered, te)
+		}
+		// Time entries are returned newest first, list them in chronological order.
+		slices.SortStableFunc(filtered, func(a, b timeentries.GetTimeEntriesOutput) int {
+			as, _ := entryStart(a, time.UTC)
+			bs, _ := entryStart(b, time.UTC)
+			return as.Compare(bs)
+		})
+
+		if t != nil {
+			return writeTemplate(e.stdout, t, filtered)
+		}
+		switch format {
+		case "json":
+			return writeJSON(e.stdout, filtered)
+		case "jsonl":
+			return writeJSONLines(e.stdout, filtered)
+		case "csv":
+			return writeCSV(e, filtered)
+		default:
+			return writeTable(e, filtered)
+		}
+	}
+}
+
+// listRange returns the range of start times to list, from the relative range in args
+// and the since and until flags, in the timezone of now. It defaults to today.
+func listRange(args []string, since, until string, now time.Time) (time.Time, time.Time, error) {
+	name := "today"
+	if len(args) == 1 {
+		name = args[0]
+	} else if since != "" || until != "" {
+		name = ""
+	}
+	var start, end time.Time
+	if name != "" {
+		var err error
+		if start, end, err = relativeRange(name, now); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	} else {
+		end = now
+	}
+	if since != "" {
+		t, err := parseDate(since, false, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid -since: %w", err)
+		}
+		start = t
+	}
+	if until != "" {
+		t, err := parseDate(until, true, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid -until: %w", err)
+		}
+		end = t
+	}
+	if start.IsZero() {
+		return time.Time{}, time.Time{}, errors.New("-until requires -since or a relative range")
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errors.New("the range is empty")
+	}
+	return start, end, nil
+}
+
+// relativeRange returns the start and end of the named range around now, in the timezone of now.
+// Weeks start on Monday.
+func relativeRange(name string, now time.Time) (time.Time, time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monday := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	switch name {
+	case "today":
+		return today, today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), today, nil
+	case "this-week":
+		return monday, monday.AddDate(0, 0, 7), nil
+	case "last-week":
+		return monday.AddDate(0, 0, -7), monday, nil
+	case "this-month":
+		return month, month.AddDate(0, 1, 0), nil
+	case "last-month":
+		return month.AddDate(0, -1, 0), month, nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("unknown range %q, use one of %s", name, strings.Join(ranges, ", "))
+}
+
+// parseDate parses a YYYY-MM-DD date in loc or an RFC3339 time.
+// If end is true, a date is parsed as the end of that day.
+func parseDate(value string, end bool, loc *time.Location) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, value, loc); err == nil {
+		if end {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither YYYY-MM-DD nor RFC3339", value)
+	}
+	return t, nil
+}
+
+// matchesProject reports whether the time entry belongs to the project given by ID or by name.
+func matchesProject(e *env, te timeentries.GetTimeEntriesOutput, project string) bool {
+	if id, err := strconv.Atoi(project); err == nil {
+		return te.ProjectId == id
+	}
+	return te.ProjectId != 0 && strings.EqualFold(entryProject(e, te), project)
+}
+
+// hasTags reports whether the time entry has all the tags.
+func hasTags(te timeentries.GetTimeEntriesOutput, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.ContainsFunc(te.Tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// entryProject returns the project name of the time entry.
+func entryProject(e *env, te timeentries.GetTimeEntriesOutput) string {
+	if te.ProjectName != "" {
+		return te.ProjectName
+	}
+	return e.projectName(te.ProjectId)
+}
+
+func writeTemplate(w io.Writer, t *template.Template, entries []timeentries.GetTimeEntriesOutput) error {
+	for _, te := range entries {
+		if err := t.Execute(w, te); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func writeJSON(w io.Writer, entries []timeentries.GetTimeEntriesOutput) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
+
+func writeJSONLines(w io.Writer, entries []timeentries.GetTimeEntriesOutput) error {
+	enc := json.NewEncoder(w)
+	for _, te := range entries {
+		if err := enc.Encode(te); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCSV(e *env, entries []timeentries.GetTimeEntriesOutput) error {
+	w := csv.NewWriter(e.stdout)
+	w.Write([]string{"id", "workspace_id", "start", "stop", "duration", "project_id", "project", "tags", "billable", "description"})
+	for _, te := range entries {
+		w.Write([]string{
+			strconv.Itoa(te.Id),
+			strconv.Itoa(te.WorkspaceId),
+			te.Start,
+			te.Stop,
+			strconv.Itoa(int(elapsed(te, e.now()).Seconds())),
+			strconv.Itoa(te.ProjectId),
+			entryProject(e, te),
+			strings.Join(te.Tags, ","),
+			strconv.FormatBool(te.Billable),
+			te.Description,
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func writeTable(e *env, entries []timeentries.GetTimeEntriesOutput) error {
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDATE\tSTART\tSTOP\tDURATION\tPROJECT\tTAGS\tDESCRIPTION")
+	var total time.Duration
+	for _, te := range entries {
+		start, err := entryStart(te, e.location)
+		if err != nil {
+			return err
+		}
+		stop := "running"
+		if te.Duration >= 0 {
+			stop = start.Add(time.Duration(te.Duration) * time.Second).Format("15:04")
+		}
+		d := elapsed(te, e.now())
+		total += d
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			te.Id, start.Format(time.DateOnly), start.Format("15:04"), stop, formatElapsed(d),
+			entryProject(e, te), strings.Join(te.Tags, ", "), te.Description)
+	}
+	fmt.Fprintf(w, "\t\t\tTOTAL\t%s\n", formatElapsed(total))
+	return w.Flush()
+}
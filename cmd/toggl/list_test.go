@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/google/go-cmp/cmp"
+)
+
+const entriesResponse = `[
+	{"id":3,"description":"Review","project_id":20,"project_name":"Internal","duration":-1,"start":"2024-05-15T09:30:00+00:00","workspace_id":1},
+	{"id":2,"description":"Write docs","project_id":10,"project_name":"Website","tags":["docs","web"],"billable":true,"duration":1800,"start":"2024-05-15T08:00:00+00:00","workspace_id":1},
+	{"id":1,"description":"Standup","duration":900,"start":"2024-05-15T07:00:00+00:00","workspace_id":1}
+]`
+
+func TestRelativeRange(t *testing.T) {
+	loc := time.FixedZone("JST", 9*60*60)
+	now := time.Date(2024, 5, 15, 10, 0, 0, 0, loc) // Wednesday
+	day := func(m time.Month, d int) time.Time { return time.Date(2024, m, d, 0, 0, 0, 0, loc) }
+	test := []struct {
+		name      string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"today", day(5, 15), day(5, 16)},
+		{"yesterday", day(5, 14), day(5, 15)},
+		{"this-week", day(5, 13), day(5, 20)},
+		{"last-week", day(5, 6), day(5, 13)},
+		{"this-month", day(5, 1), day(6, 1)},
+		{"last-month", day(4, 1), day(5, 1)},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := relativeRange(tt.name, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Expected %v - %v, got %v - %v", tt.wantStart, tt.wantEnd, start, end)
+			}
+		})
+	}
+	if _, _, err := relativeRange("tomorrow", now); err == nil {
+		t.Error("Expected an error for an unknown range")
+	}
+}
+
+func TestListRange(t *testing.T) {
+	now := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC) }
+	test := []struct {
+		name      string
+		args      []string
+		since     string
+		until     string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{name: "default", wantStart: day(15), wantEnd: day(16)},
+		{name: "since", since: "2024-05-01", wantStart: day(1), wantEnd: now},
+		{name: "since and until", since: "2024-05-01", until: "2024-05-03", wantStart: day(1), wantEnd: day(4)},
+		{name: "range and until", args: []string{"this-week"}, until: "2024-05-14", wantStart: day(13), wantEnd: day(15)},
+		{name: "until only", until: "2024-05-03", wantErr: true},
+		{name: "empty", since: "2024-05-03", until: "2024-05-01", wantErr: true},
+		{name: "invalid", since: "May 1", wantErr: true},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := listRange(tt.args, tt.since, tt.until, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Expected %v - %v, got %v - %v", tt.wantStart, tt.wantEnd, start, end)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	test := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "table",
+			args: []string{"list"},
+			want: "ID  DATE        START  STOP     DURATION  PROJECT   TAGS       DESCRIPTION\n" +
+				"1   2024-05-15  07:00  07:15    0:15:00                        Standup\n" +
+				"2   2024-05-15  08:00  08:30    0:30:00   Website   docs, web  Write docs\n" +
+				"3   2024-05-15  09:30  running  0:30:00   Internal             Review\n" +
+				"                       TOTAL    1:15:00\n",
+		},
+		{
+			name: "csv",
+			args: []string{"list", "-format", "csv", "-p", "website"},
+			want: "id,workspace_id,start,stop,duration,project_id,project,tags,billable,description\n" +
+				"2,1,2024-05-15T08:00:00+00:00,,1800,10,Website,\"docs,web\",true,Write docs\n",
+		},
+		{
+			name: "tag",
+			args: []string{"list", "-t", "docs", "-template", "{{.Id}}"},
+			want: "2\n",
+		},
+		{
+			name: "template",
+			args: []string{"list", "-match", "^(Standup|Review)$", "-template", "{{.Id}}\t{{.Description}}\t{{join .Tags \",\"}}"},
+			want: "1\tStandup\t\n3\tReview\t\n",
+		},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{responses: map[string]string{
+				"GET /api/v9/me/time_entries": entriesResponse,
+			}}
+			e, stdout, stderr := newTestEnv(api)
+			if code := run(tt.args, e); code != 0 {
+				t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("Expected\n%s\ngot\n%s", tt.want, stdout.String())
+			}
+		})
+	}
+}
+
+func TestListJSONEmpty(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries": entriesResponse,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+	if code := run([]string{"list", "-o", "json", "-match", "nothing"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	if want := "[]\n"; stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+}
+
+func TestListJSONLines(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries": entriesResponse,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+	if code := run([]string{"list", "-o", "jsonl"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	var ids []int
+	sc := bufio.NewScanner(stdout)
+	for sc.Scan() {
+		te := timeentries.GetTimeEntriesOutput{}
+		if err := json.Unmarshal(sc.Bytes(), &te); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, te.Id)
+	}
+	if want := []int{1, 2, 3}; !cmp.Equal(want, ids) {
+		t.Errorf("diff: %v", cmp.Diff(want, ids))
+	}
+}
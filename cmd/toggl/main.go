@@ -0,0 +1,218 @@
+// Command toggl is a command-line client for Toggl Track built on the toggl-go packages.
+//
+// Usage:
+//
+//	toggl [-profile name] <command> [flags] [arguments]
+//
+// Run "toggl help" for the list of commands.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// command is a subcommand of the CLI.
+type command struct {
+	name    string
+	args    string // Synopsis of the positional arguments
+	summary string
+	// setup defines the flags of the command on fs and returns the function running it
+	// with the remaining positional arguments.
+	setup    func(fs *flag.FlagSet) func(e *env, args []string) error
+	complete completion // Candidates for the positional arguments
+	hidden   bool       // Not listed in the usage
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "start", args: "[description]", summary: "Start a new time entry", setup: setupStart,
+			complete: completion{dynamic: "descriptions"}},
+		{name: "stop", summary: "Stop the running time entry", setup: setupStop},
+		{name: "status", summary: "Show the running time entry", setup: setupStatus},
+		{name: "continue", args: "[time entry ID]", summary: "Start a new time entry like the last or the given one", setup: setupContinue},
+		{name: "edit", args: "[time entry ID]", summary: "Change a time entry, the running one by default", setup: setupEdit},
+		{name: "list", args: "[" + strings.Join(ranges, "|") + "]", summary: "List time entries", setup: setupList,
+			complete: completion{words: ranges}},
+		{name: "git", args: "install | uninstall | sessions [" + strings.Join(ranges, "|") + "]", summary: "Install git hooks or create time entries from commits", setup: setupGit,
+			complete: completion{words: []string{"install", "uninstall", "sessions"}}},
+		{name: "queue", args: "list | replay | clear", summary: "Show, replay or discard the starts and stops queued while offline", setup: setupQueue,
+			complete: completion{words: []string{"list", "replay", "clear"}}},
+		{name: "dashboard", summary: "Show a live dashboard of the running and recent time entries", setup: setupDashboard},
+		{name: "daemon", summary: "Serve the running and recent time entries on a Unix socket", setup: setupDaemon},
+		{name: "exporter", summary: "Serve the tracked time and API metrics for Prometheus", setup: setupExporter},
+		{name: "profile", args: "list | add <name> | switch <name> | remove <name>", summary: "Manage configuration profiles", setup: setupProfile,
+			complete: completion{words: []string{"list", "add", "switch", "remove"}, dynamic: "profiles"}},
+		{name: "help", args: "[command]", summary: "Show help for a command", setup: setupHelp,
+			complete: completion{dynamic: "commands"}},
+		{name: "completion", args: "bash|zsh|fish", summary: "Print the shell completion script", setup: setupCompletion,
+			complete: completion{words: shells}},
+		{name: "__complete", args: "<kind>", summary: "Print completion candidates", setup: setupComplete, hidden: true},
+	}
+}
+
+// errUsage is returned by commands called with invalid arguments.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], newEnv(os.Stdin, os.Stdout, os.Stderr)))
+}
+
+// run executes the command line args and returns the exit code.
+func run(args []string, e *env) int {
+	slog.SetDefault(slog.New(slog.NewTextHandler(e.stderr, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+
+	global, profileName := newGlobalFlagSet(e.stderr)
+	if err := global.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	args = global.Args()
+
+	if len(args) == 0 {
+		printUsage(e.stderr)
+		return 2
+	}
+	cmd, ok := findCommand(args[0])
+	if !ok {
+		fmt.Fprintf(e.stderr, "toggl: unknown command %q\n", args[0])
+		printUsage(e.stderr)
+		return 2
+	}
+	// The profile command manages the profiles itself, so none needs to be selected for it.
+	if cmd.name != "profile" && cmd.name != "help" {
+		if err := e.useProfile(*profileName); err != nil {
+			fmt.Fprintf(e.stderr, "toggl: %v\n", err)
+			return 1
+		}
+	}
+
+	fs := newFlagSet(cmd, e.stderr)
+	runCmd := cmd.setup(fs)
+	rest, err := parseInterspersed(fs, args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		return 2
+	}
+	if err := runCmd(e, rest); err != nil {
+		if errors.Is(err, errUsage) {
+			fs.Usage()
+			return 2
+		}
+		fmt.Fprintf(e.stderr, "toggl %s: %v\n", cmd.name, err)
+		return 1
+	}
+	return 0
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// newGlobalFlagSet returns the flag set of the flags before the command, and the selected profile.
+func newGlobalFlagSet(w io.Writer) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet("toggl", flag.ContinueOnError)
+	fs.SetOutput(w)
+	fs.Usage = func() { printUsage(w) }
+	profile := fs.String("profile", "", "use the `name`d profile instead of the current one")
+	return fs, profile
+}
+
+func newFlagSet(cmd command, w io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(w)
+	fs.Usage = func() {
+		fmt.Fprintf(w, "Usage: toggl %s [flags] %s\n\n%s.\n", cmd.name, cmd.args, cmd.summary)
+		hasFlags := false
+		fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintln(w, "\nFlags:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// parseInterspersed parses the flags in args, which may appear after positional arguments,
+// and returns the positional arguments. Arguments after "--" are never parsed as flags.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var literal []string
+	for i, arg := range args {
+		if arg == "--" {
+			args, literal = args[:i], args[i+1:]
+			break
+		}
+	}
+	positional := make([]string, 0, len(args)+len(literal))
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	return append(positional, literal...), nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: toggl [-profile name] <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	width := 0
+	for _, cmd := range commands {
+		if !cmd.hidden {
+			width = max(width, len(cmd.name))
+		}
+	}
+	for _, cmd := range commands {
+		if !cmd.hidden {
+			fmt.Fprintf(w, "  %-*s  %s\n", width, cmd.name, cmd.summary)
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "toggl help <command>" for the flags of a command.`)
+	fmt.Fprintln(w, `The -profile flag or $TOGGL_PROFILE selects a profile other than the current one.`)
+}
+
+func setupHelp(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) == 0 {
+			printUsage(e.stdout)
+			return nil
+		}
+		cmd, ok := findCommand(args[0])
+		if !ok {
+			return fmt.Errorf("unknown command %q", args[0])
+		}
+		cfs := newFlagSet(cmd, e.stdout)
+		cmd.setup(cfs)
+		cfs.Usage()
+		return nil
+	}
+}
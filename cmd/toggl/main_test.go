@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/toggl"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeAPI answers requests with the body registered for "METHOD /path" and records them.
+type fakeAPI struct {
+	responses map[string]string
+	requests  []string
+	bodies    map[string][]byte
+	statuses  map[string]int // Status codes other than 200 of the responses
+	offline   bool           // Fail every request as if Toggl could not be reached
+}
+
+func (f *fakeAPI) Do(r *http.Request) (*http.Response, error) {
+	key := r.Method + " " + r.URL.Path
+	if f.offline {
+		return nil, &url.Error{Op: r.Method, URL: r.URL.String(), Err: errors.New("network is unreachable")}
+	}
+	f.requests = append(f.requests, key)
+	if r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if f.bodies == nil {
+			f.bodies = map[string][]byte{}
+		}
+		f.bodies[key] = b
+	}
+	body, ok := f.responses[key]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+	}
+	status := http.StatusOK
+	if s, ok := f.statuses[key]; ok {
+		status = s
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+var testNow = time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+
+func newTestEnv(api *fakeAPI) (*env, *bytes.Buffer, *bytes.Buffer) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	e := newEnv(strings.NewReader(""), stdout, stderr)
+	e.now = func() time.Time { return testNow }
+	e.getenv = func(string) string { return "" }
+	e.location = time.UTC
+	c := toggl.NewClient("token")
+	c.TimeEntriesClient.HttpClient = api
+	c.MeClient.HttpClient = api
+	e.client = &c
+	return e, stdout, stderr
+}
+
+func TestParseInterspersed(t *testing.T) {
+	test := []struct {
+		name        string
+		args        []string
+		wantArgs    []string
+		wantProject string
+	}{
+		{"flags first", []string{"-p", "x", "a", "b"}, []string{"a", "b"}, "x"},
+		{"flags last", []string{"a", "b", "-p", "x"}, []string{"a", "b"}, "x"},
+		{"flags between", []string{"a", "-p=x", "b"}, []string{"a", "b"}, "x"},
+		{"terminator", []string{"a", "--", "-p", "x"}, []string{"a", "-p", "x"}, ""},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			project := fs.String("p", "", "")
+			got, err := parseInterspersed(fs, tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tt.wantArgs, got) {
+				t.Errorf("diff: %v", cmp.Diff(tt.wantArgs, got))
+			}
+			if *project != tt.wantProject {
+				t.Errorf("Expected project %q, got %q", tt.wantProject, *project)
+			}
+		})
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	test := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"no command", nil, 2},
+		{"unknown command", []string{"unknown"}, 2},
+		{"unknown flag", []string{"stop", "-unknown"}, 2},
+		{"extra argument", []string{"stop", "now"}, 2},
+		{"help", []string{"help", "start"}, 0},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _, _ := newTestEnv(&fakeAPI{})
+			if got := run(tt.args, e); got != tt.want {
+				t.Errorf("Expected exit code %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+func setupProfile(fs *flag.FlagSet) func(e *env, args []string) error {
+	var (
+		p       profile
+		stdin   bool
+		aliases stringList
+	)
+	fs.StringVar(&p.Token.Env, "token-env", "", "add: read the token from the environment `variable`")
+	fs.StringVar(&p.Token.File, "token-file", "", "add: read the token from the `file`")
+	fs.StringVar(&p.Token.Command, "token-command", "", "add: read the token from the output of the shell `command`")
+	fs.BoolVar(&stdin, "token-stdin", false, "add: read the token from standard input, prompting without echo on a terminal, and store it in a file readable only by you next to the configuration")
+	fs.IntVar(&p.Workspace, "workspace", 0, "add: default workspace `ID`")
+	fs.StringVar(&p.Timezone, "timezone", "", "add: IANA `timezone` to show times in, e.g. Asia/Tokyo")
+	fs.StringVar(&p.Format, "format", "", "add: default output `format` of list: "+strings.Join(formats, ", "))
+	fs.Var(&aliases, "alias", "add: comma separated project aliases as `alias=project`, may be repeated")
+	return func(e *env, args []string) error {
+		if len(args) == 0 {
+			args = []string{"list"}
+		}
+		if (args[0] == "list") != (len(args) == 1) || len(args) > 2 {
+			return errUsage
+		}
+		c, err := e.loadConfig()
+		if err != nil {
+			return err
+		}
+		path := configPath(e.getenv)
+
+		switch action := args[0]; action {
+		case "list":
+			return listProfiles(e, c)
+		case "add":
+			name := args[1]
+			if err := validProfileName(name); err != nil {
+				return err
+			}
+			for _, alias := range aliases {
+				a, project, ok := strings.Cut(alias, "=")
+				if !ok || a == "" || project == "" {
+					return fmt.Errorf("invalid alias %q, use alias=project", alias)
+				}
+				if p.Aliases == nil {
+					p.Aliases = map[string]string{}
+				}
+				p.Aliases[a] = project
+			}
+			if stdin {
+				if p.Token.Env != "" || p.Token.File != "" || p.Token.Command != "" {
+					return errors.New("exactly one token source of env, file or command is required")
+				}
+				if path == "" {
+					return errors.New("no configuration path, set TOGGL_CONFIG or HOME")
+				}
+				p.Token.File = tokenPath(path, name)
+			}
+			// Nothing is written before the profile is known to be valid.
+			if err := p.validate(); err != nil {
+				return err
+			}
+			if stdin {
+				token, err := readSecret(e.stdin, e.stderr, "Token: ")
+				if err != nil {
+					return fmt.Errorf("reading the token: %w", err)
+				}
+				if token == "" {
+					return errors.New("empty token on standard input")
+				}
+				if err := os.MkdirAll(filepath.Dir(p.Token.File), 0o700); err != nil {
+					return err
+				}
+				if err := os.WriteFile(p.Token.File, []byte(token+"\n"), 0o600); err != nil {
+					return err
+				}
+			}
+			old, exists := c.Profiles[name]
+			c.Profiles[name] = &p
+			if c.Current == "" {
+				c.Current = name
+			}
+			if err := c.save(path); err != nil {
+				return err
+			}
+			// A token stored by an earlier add is removed once the profile reads it from elsewhere.
+			if exists && old.Token.File == tokenPath(path, name) && p.Token.File != old.Token.File {
+				if err := os.Remove(old.Token.File); err != nil && !errors.Is(err, os.ErrNotExist) {
+					return err
+				}
+			}
+			if exists {
+				fmt.Fprintf(e.stdout, "Updated profile %q\n", name)
+			} else {
+				fmt.Fprintf(e.stdout, "Added profile %q\n", name)
+			}
+		case "switch":
+			name := args[1]
+			if _, ok := c.Profiles[name]; !ok {
+				return fmt.Errorf("unknown profile %q", name)
+			}
+			c.Current = name
+			if err := c.save(path); err != nil {
+				return err
+			}
+			fmt.Fprintf(e.stdout, "Switched to profile %q\n", name)
+		case "remove":
+			name := args[1]
+			removed, ok := c.Profiles[name]
+			if !ok {
+				return fmt.Errorf("unknown profile %q", name)
+			}
+			delete(c.Profiles, name)
+			if c.Current == name {
+				c.Current = ""
+			}
+			if err := c.save(path); err != nil {
+				return err
+			}
+			// Only the token stored by add is removed, never a file of the user.
+			if removed.Token.File == tokenPath(path, name) {
+				if err := os.Remove(removed.Token.File); err != nil && !errors.Is(err, os.ErrNotExist) {
+					return err
+				}
+			}
+			fmt.Fprintf(e.stdout, "Removed profile %q\n", name)
+		default:
+			return errUsage
+		}
+		return nil
+	}
+}
+
+// tokenPath returns the path of the token stored for the profile next to the configuration at path.
+func tokenPath(path, name string) string {
+	return filepath.Join(filepath.Dir(path), name+".token")
+}
+
+func listProfiles(e *env, c *config) error {
+	if len(c.Profiles) == 0 {
+		fmt.Fprintln(e.stdout, `No profiles, add one with "toggl profile add"`)
+		return nil
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  NAME\tTOKEN\tWORKSPACE\tTIMEZONE\tFORMAT\tALIASES")
+	for _, name := range slices.Sorted(maps.Keys(c.Profiles)) {
+		p := c.Profiles[name]
+		mark := " "
+		if name == c.Current {
+			mark = "*"
+		}
+		workspace := ""
+		if p.Workspace != 0 {
+			workspace = strconv.Itoa(p.Workspace)
+		}
+		aliases := make([]string, 0, len(p.Aliases))
+		for _, alias := range slices.Sorted(maps.Keys(p.Aliases)) {
+			aliases = append(aliases, alias+"="+p.Aliases[alias])
+		}
+		fmt.Fprintf(w, "%s %s\t%s\t%s\t%s\t%s\t%s\n", mark, name, p.Token, workspace, p.Timezone, p.Format, strings.Join(aliases, ", "))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newProfileEnv(t *testing.T, vars map[string]string) (*env, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "toggl", "config.json")
+	e, _, _ := newTestEnv(&fakeAPI{})
+	e.getenv = func(key string) string {
+		if key == "TOGGL_CONFIG" {
+			return path
+		}
+		return vars[key]
+	}
+	return e, path
+}
+
+func TestConfigPath(t *testing.T) {
+	test := []struct {
+		name string
+		vars map[string]string
+		want string
+	}{
+		{"explicit", map[string]string{"TOGGL_CONFIG": "/etc/toggl.json", "HOME": "/home/u"}, "/etc/toggl.json"},
+		{"xdg", map[string]string{"XDG_CONFIG_HOME": "/xdg", "HOME": "/home/u"}, "/xdg/toggl/config.json"},
+		{"home", map[string]string{"HOME": "/home/u"}, "/home/u/.config/toggl/config.json"},
+		{"none", map[string]string{}, ""},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := configPath(func(key string) string { return tt.vars[key] }); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProfileCommands(t *testing.T) {
+	e, path := newProfileEnv(t, nil)
+	// A relative configuration path keeps the token path short in the listing.
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(filepath.Dir(filepath.Dir(path))); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	path = filepath.Join("toggl", "config.json")
+	e.getenv = func(key string) string {
+		if key == "TOGGL_CONFIG" {
+			return path
+		}
+		return ""
+	}
+	step := func(args []string, want string) {
+		t.Helper()
+		stdout, stderr := &strings.Builder{}, &strings.Builder{}
+		e.stdout, e.stderr = stdout, stderr
+		if code := run(args, e); code != 0 {
+			t.Fatalf("%v: expected exit code 0, got %d: %s", args, code, stderr)
+		}
+		if stdout.String() != want {
+			t.Errorf("%v: expected\n%s\ngot\n%s", args, want, stdout.String())
+		}
+		e.config = nil
+	}
+
+	step([]string{"profile", "list"}, "No profiles, add one with \"toggl profile add\"\n")
+	e.stdin = strings.NewReader("secret\n")
+	step([]string{"profile", "add", "work", "-token-stdin", "-workspace", "5", "-timezone", "Asia/Tokyo", "-format", "csv", "-alias", "web=Website,ops=42"},
+		"Added profile \"work\"\n")
+	step([]string{"profile", "add", "personal", "-token-env", "MY_TOKEN", "-workspace", "7", "-timezone", "UTC", "-format", "json", "-alias", "x=y"},
+		"Added profile \"personal\"\n")
+
+	tokenFile := filepath.Join(filepath.Dir(path), "work.token")
+	b, err := os.ReadFile(tokenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "secret\n" {
+		t.Errorf("Expected the stored token, got %q", b)
+	}
+	if info, err := os.Stat(tokenFile); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected a token readable only by the user, got %v %v", info.Mode(), err)
+	}
+
+	step([]string{"profile"},
+		"  NAME      TOKEN                  WORKSPACE  TIMEZONE    FORMAT  ALIASES\n"+
+			"  personal  env MY_TOKEN           7          UTC         json    x=y\n"+
+			"* work      file toggl/work.token  5          Asia/Tokyo  csv     ops=42, web=Website\n")
+	step([]string{"profile", "switch", "personal"}, "Switched to profile \"personal\"\n")
+	step([]string{"profile", "remove", "work"}, "Removed profile \"work\"\n")
+	if _, err := os.Stat(tokenFile); !os.IsNotExist(err) {
+		t.Errorf("Expected the stored token to be removed, got %v", err)
+	}
+
+	// The stored token is removed when the profile reads it from elsewhere.
+	e.stdin = strings.NewReader("secret")
+	step([]string{"profile", "add", "work", "-token-stdin"}, "Added profile \"work\"\n")
+	step([]string{"profile", "add", "work", "-token-env", "WORK_TOKEN"}, "Updated profile \"work\"\n")
+	if _, err := os.Stat(tokenFile); !os.IsNotExist(err) {
+		t.Errorf("Expected the stored token to be removed, got %v", err)
+	}
+	step([]string{"profile", "remove", "work"}, "Removed profile \"work\"\n")
+
+	c, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &config{
+		Current: "personal",
+		Profiles: map[string]*profile{
+			"personal": {Token: tokenSource{Env: "MY_TOKEN"}, Workspace: 7, Timezone: "UTC", Format: "json", Aliases: map[string]string{"x": "y"}},
+		},
+	}
+	if !cmp.Equal(want, c) {
+		t.Errorf("diff: %v", cmp.Diff(want, c))
+	}
+}
+
+func TestProfileAddErrors(t *testing.T) {
+	test := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"no token", []string{"profile", "add", "work"}, "exactly one token source of env, file or command is required"},
+		{"two tokens", []string{"profile", "add", "work", "-token-stdin", "-token-env", "X"}, "exactly one token source of env, file or command is required"},
+		{"timezone", []string{"profile", "add", "work", "-token-env", "X", "-timezone", "Mars/Olympus"}, "invalid timezone \"Mars/Olympus\""},
+		{"format", []string{"profile", "add", "work", "-token-env", "X", "-format", "xml"}, "unknown format \"xml\", use one of table, json, jsonl, csv"},
+		{"alias", []string{"profile", "add", "work", "-token-env", "X", "-alias", "web"}, "invalid alias \"web\", use alias=project"},
+		{"unknown", []string{"profile", "switch", "work"}, "unknown profile \"work\""},
+		{"path", []string{"profile", "add", "../work", "-token-stdin"}, "invalid profile name \"../work\", use letters, digits, _ and -"},
+		{"empty", []string{"profile", "add", "", "-token-stdin"}, "invalid profile name \"\", use letters, digits, _ and -"},
+		{"empty token", []string{"profile", "add", "work", "-token-stdin"}, "empty token on standard input"},
+		{"invalid token", []string{"profile", "add", "work", "-token-stdin", "-timezone", "Mars/Olympus"}, "invalid timezone \"Mars/Olympus\""},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			e, path := newProfileEnv(t, nil)
+			stderr := &strings.Builder{}
+			e.stderr = stderr
+			if code := run(tt.args, e); code != 1 {
+				t.Fatalf("Expected exit code 1, got %d", code)
+			}
+			if want := "toggl profile: " + tt.want + "\n"; stderr.String() != want {
+				t.Errorf("Expected %q, got %q", want, stderr.String())
+			}
+			// Nothing is written for an invalid profile.
+			if _, err := os.Stat(filepath.Dir(path)); !os.IsNotExist(err) {
+				t.Errorf("expected no configuration directory, got %v", err)
+			}
+		})
+	}
+}
+
+func TestProfileToken(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(file, []byte(" from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	test := []struct {
+		name    string
+		source  tokenSource
+		want    string
+		wantErr bool
+	}{
+		{name: "env", source: tokenSource{Env: "MY_TOKEN"}, want: "from-env"},
+		{name: "file", source: tokenSource{File: file}, want: "from-file"},
+		{name: "command", source: tokenSource{Command: "echo from-command"}, want: "from-command"},
+		{name: "empty env", source: tokenSource{Env: "NO_TOKEN"}, wantErr: true},
+		{name: "failing command", source: tokenSource{Command: "exit 1"}, wantErr: true},
+		{name: "empty command", source: tokenSource{Command: "true"}, wantErr: true},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _ := newProfileEnv(t, map[string]string{"MY_TOKEN": "from-env"})
+			p := &profile{Token: tt.source}
+			got, err := p.token(e)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProfileSettings(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/projects":                projectsResponse,
+		"GET /api/v9/me/time_entries":            entriesResponse,
+		"POST /api/v9/workspaces/1/time_entries": `{"id":100,"project_id":10,"workspace_id":1}`,
+	}}
+	e, path := newProfileEnv(t, nil)
+	e.client.TimeEntriesClient.HttpClient = api
+	e.client.MeClient.HttpClient = api
+	c := &config{Current: "work", Profiles: map[string]*profile{
+		"work":  {Token: tokenSource{Env: "X"}, Workspace: 1, Timezone: "Asia/Tokyo", Format: "csv", Aliases: map[string]string{"web": "Website"}},
+		"other": {Token: tokenSource{Env: "X"}, Format: "json"},
+	}}
+	if err := c.save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := &strings.Builder{}
+	e.stdout = stdout
+	if code := run([]string{"start", "-p", "web"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	if want := "Started (no description) [Website] at 19:00\n"; stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+
+	stdout.Reset()
+	if code := run([]string{"list", "-p", "web", "-template", "{{.Id}}"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	if want := "2\n"; stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+
+	stdout.Reset()
+	if code := run([]string{"-profile", "other", "list", "-match", "nothing"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	if want := "[]\n"; stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/dev-shimada/toggl-go/toggl"
+)
+
+// queuedOp is a start or stop recorded while Toggl could not be reached, replayed later in order.
+type queuedOp struct {
+	Op string    `json:"op"` // start or stop
+	At time.Time `json:"at"` // When the time entry was started or stopped
+	// EntryId of a start is a negative temporary ID, replaced by the real ID in the
+	// queued stops once the start is replayed. EntryId of a stop is the time entry to
+	// stop, or 0 for the one running when it is replayed.
+	EntryId     int      `json:"entry_id"`
+	Description string   `json:"description,omitempty"`
+	Project     string   `json:"project,omitempty"` // Project name, alias or ID, resolved on replay
+	Tags        []string `json:"tags,omitempty"`
+	Billable    bool     `json:"billable,omitempty"`
+	WorkspaceId int      `json:"workspace_id,omitempty"` // Defaults to the default workspace on replay
+}
+
+// errConflict marks an operation that cannot be replayed, so it is dropped.
+var errConflict = errors.New("conflict")
+
+// isOffline reports whether err is a failure to reach Toggl or a temporary error response,
+// a rate limit or server error, rather than an error response that would be the same on a retry.
+func isOffline(err error) bool {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		return true
+	}
+	status := statusCode(err)
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// isErrorResponse reports whether err is an error response of Toggl.
+func isErrorResponse(err error) bool {
+	return errors.Is(err, timeentries.ErrorStatusNotOK) || errors.Is(err, me.ErrorStatusNotOK)
+}
+
+// statusCode returns the status code of the error response err, 0 if it is none.
+func statusCode(err error) int {
+	var ts *timeentries.StatusError
+	if errors.As(err, &ts) {
+		return ts.StatusCode
+	}
+	var ms *me.StatusError
+	if errors.As(err, &ms) {
+		return ms.StatusCode
+	}
+	return 0
+}
+
+// queuePath returns the path of the queue of the profile: toggl/queue.jsonl, or
+// toggl/queue-<profile>.jsonl, in $XDG_STATE_HOME or ~/.local/state.
+// It returns an empty string if there is no home directory.
+func queuePath(getenv func(string) string, profileName string) string {
+	dir := getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home := getenv("HOME")
+		if home == "" {
+			return ""
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	name := "queue.jsonl"
+	if profileName != "" {
+		name = "queue-" + profileName + ".jsonl"
+	}
+	return filepath.Join(dir, "toggl", name)
+}
+
+// loadQueue reads the queued operations at path. A missing file is an empty queue.
+func loadQueue(path string) ([]queuedOp, error) {
+	if path == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ops []queuedOp
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; sc.Scan(); n++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		op := queuedOp{}
+		if err := json.Unmarshal(sc.Bytes(), &op); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, n, err)
+		}
+		ops = append(ops, op)
+	}
+	return ops, sc.Err()
+}
+
+// saveQueue replaces the queue at path with ops, removing it if there are none.
+func saveQueue(path string, ops []queuedOp) error {
+	if len(ops) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	for _, op := range ops {
+		if err := enc.Encode(op); err != nil {
+			return err
+		}
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b.Bytes(), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// enqueue appends op to the queue of the profile and reports it.
+func enqueue(e *env, op queuedOp) error {
+	path := queuePath(e.getenv, e.profileName)
+	if path == "" {
+		return fmt.Errorf("Toggl cannot be reached and there is no directory to queue the %s in, set HOME", op.Op)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	b, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(e.stdout, "Toggl cannot be reached, queued %s\n", describeOp(e, op))
+	return nil
+}
+
+// queueStart queues a start of the time entry at now with a new temporary ID.
+func queueStart(e *env, op queuedOp) error {
+	ops, err := loadQueue(queuePath(e.getenv, e.profileName))
+	if err != nil {
+		return err
+	}
+	op.Op, op.At, op.EntryId = "start", e.now(), -1
+	for _, queued := range ops {
+		op.EntryId = min(op.EntryId, queued.EntryId-1)
+	}
+	return enqueue(e, op)
+}
+
+// queueStop queues a stop at now of the last queued start, or of the time entry running on replay.
+// Nothing is queued if the last queued operation is already a stop.
+func queueStop(e *env) error {
+	ops, err := loadQueue(queuePath(e.getenv, e.profileName))
+	if err != nil {
+		return err
+	}
+	op := queuedOp{Op: "stop", At: e.now()}
+	if len(ops) > 0 {
+		last := ops[len(ops)-1]
+		if last.Op == "stop" {
+			fmt.Fprintf(e.stdout, "Toggl cannot be reached, already queued %s\n", describeOp(e, last))
+			return nil
+		}
+		op.EntryId = last.EntryId
+	}
+	return enqueue(e, op)
+}
+
+// describeOp describes the queued operation, e.g. `start of "Write docs" [Website] at 2024-05-15 09:30`.
+func describeOp(e *env, op queuedOp) string {
+	at := op.At.In(e.location).Format("2006-01-02 15:04")
+	if op.Op == "stop" {
+		return "stop at " + at
+	}
+	te := timeentries.GetTimeEntriesOutput{Description: op.Description, Tags: op.Tags, Billable: op.Billable}
+	return fmt.Sprintf("start of %s at %s", describe(te, op.Project), at)
+}
+
+// replayQueue replays the queued operations of the profile in order, reporting each one.
+// Operations that conflict with the time entries in Toggl or that Toggl refuses are
+// reported and dropped, so that they do not hold up the rest of the queue.
+// It stops at the first operation that cannot reach Toggl or is rate limited or fails
+// on the server, keeping it and the rest queued, and reports whether Toggl is offline.
+func replayQueue(e *env) (bool, error) {
+	path := queuePath(e.getenv, e.profileName)
+	ops, err := loadQueue(path)
+	if err != nil {
+		return false, err
+	}
+	for len(ops) > 0 {
+		op := ops[0]
+		id, err := replayOp(e, op)
+		if isOffline(err) {
+			return true, nil
+		}
+		switch {
+		case errors.Is(err, errConflict):
+			fmt.Fprintf(e.stderr, "Dropped queued %s: %v\n", describeOp(e, op), err)
+		case isErrorResponse(err):
+			fmt.Fprintf(e.stderr, "Dropped queued %s, refused by Toggl: %v\n", describeOp(e, op), err)
+		case err != nil:
+			return false, fmt.Errorf("replaying the queued %s in %s: %w", describeOp(e, op), path, err)
+		default:
+			fmt.Fprintf(e.stdout, "Replayed queued %s\n", describeOp(e, op))
+		}
+		ops = ops[1:]
+		// The stops of a replayed start refer to its real ID from now on.
+		if op.Op == "start" && id != 0 {
+			for i := range ops {
+				if ops[i].EntryId == op.EntryId {
+					ops[i].EntryId = id
+				}
+			}
+		}
+		if err := saveQueue(path, ops); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// replayOp sends the queued operation to Toggl. It returns the ID of the started time entry.
+// Errors wrapping errConflict mean the operation cannot be replayed.
+func replayOp(e *env, op queuedOp) (int, error) {
+	c, err := e.togglClient()
+	if err != nil {
+		return 0, err
+	}
+	switch op.Op {
+	case "start":
+		// A start replayed before the queue could be saved, e.g. when interrupted, is not created again.
+		if id, err := replayedStart(c, op); err != nil || id != 0 {
+			return id, err
+		}
+		running, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+		if err != nil {
+			return 0, err
+		}
+		// The entry running since before the start is stopped at it, as start would have done.
+		var runningStart time.Time
+		if running.Id != 0 {
+			if runningStart, err = entryStart(running, time.UTC); err != nil {
+				return 0, err
+			}
+			if !runningStart.Before(op.At) {
+				return 0, fmt.Errorf("%w: the running time entry %d %s started at %s, after the start", errConflict, running.Id, describe(running, e.projectName(running.ProjectId)), runningStart.In(e.location).Format("15:04"))
+			}
+		}
+		workspaceId, err := e.workspaceId(op.WorkspaceId)
+		if err != nil {
+			return 0, err
+		}
+		projectId, err := e.resolveProject(workspaceId, op.Project)
+		if err != nil {
+			if isOffline(err) {
+				return 0, err
+			}
+			return 0, fmt.Errorf("%w: %v", errConflict, err)
+		}
+		if running.Id != 0 {
+			if err := stopEntryAt(c, running, runningStart, op.At); err != nil {
+				return 0, err
+			}
+		}
+		te, err := startEntryAt(e, timeentries.PostTimeEntriesBody{
+			Billable:    op.Billable,
+			Description: op.Description,
+			ProjectId:   projectId,
+			Tags:        op.Tags,
+			WorkspaceId: workspaceId,
+		}, op.At)
+		return te.Id, err
+	case "stop":
+		var te timeentries.GetTimeEntriesOutput
+		switch {
+		case op.EntryId < 0:
+			return 0, fmt.Errorf("%w: the queued start of the time entry was dropped", errConflict)
+		case op.EntryId == 0:
+			if te, err = c.TimeEntriesClient.GetCurrentTimeEntry(); err != nil {
+				return 0, err
+			}
+			if te.Id == 0 {
+				return 0, fmt.Errorf("%w: no time entry is running", errConflict)
+			}
+		default:
+			if te, err = c.TimeEntriesClient.GetATimeEntryById(timeentries.GetATimeEntryByIdInput{TimeEntryId: op.EntryId}); err != nil {
+				return 0, err
+			}
+			if te.Id == 0 {
+				return 0, fmt.Errorf("%w: time entry %d not found", errConflict, op.EntryId)
+			}
+			if te.Duration >= 0 {
+				return 0, fmt.Errorf("%w: time entry %d is already stopped", errConflict, te.Id)
+			}
+		}
+		start, err := entryStart(te, time.UTC)
+		if err != nil {
+			return 0, err
+		}
+		if !op.At.After(start) {
+			return 0, fmt.Errorf("%w: the running time entry %d %s started at %s, after the stop", errConflict, te.Id, describe(te, e.projectName(te.ProjectId)), start.In(e.location).Format("15:04"))
+		}
+		return 0, stopEntryAt(c, te, start, op.At)
+	}
+	return 0, fmt.Errorf("%w: unknown operation %q", errConflict, op.Op)
+}
+
+// replayedStart returns the ID of the time entry created by the queued start, 0 if there is none.
+func replayedStart(c toggl.Client, op queuedOp) (int, error) {
+	at := op.At.UTC().Truncate(time.Second)
+	startDate, endDate := at.Format(time.RFC3339), at.Add(time.Second).Format(time.RFC3339)
+	entries, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{
+		Query: timeentries.GetTimeEntriesQuery{StartDate: &startDate, EndDate: &endDate},
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, te := range entries {
+		if start, err := entryStart(te, time.UTC); err == nil && start.Equal(at) && te.Description == op.Description {
+			return te.Id, nil
+		}
+	}
+	return 0, nil
+}
+
+// stopEntryAt stops the running time entry, which started at start, at at.
+func stopEntryAt(c toggl.Client, te timeentries.GetTimeEntriesOutput, start, at time.Time) error {
+	s := start.Format(time.RFC3339)
+	_, err := c.TimeEntriesClient.PutTimeEntries(timeentries.PutTimeEntriesInput{
+		WorkspaceId: te.WorkspaceId,
+		TimeEntryId: te.Id,
+		Body: timeentries.PutTimeEntriesBody{
+			Duration:    int(at.Sub(start).Seconds()),
+			Start:       &s,
+			Stop:        at.UTC().Format(time.RFC3339),
+			WorkspaceId: te.WorkspaceId,
+		},
+	})
+	return err
+}
+
+func setupQueue(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) == 0 {
+			args = []string{"list"}
+		}
+		if len(args) > 1 {
+			return errUsage
+		}
+		path := queuePath(e.getenv, e.profileName)
+		ops, err := loadQueue(path)
+		if err != nil {
+			return err
+		}
+		switch args[0] {
+		case "list":
+			if len(ops) == 0 {
+				fmt.Fprintln(e.stdout, "No queued operations")
+				return nil
+			}
+			w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "OP\tAT\tENTRY\tDESCRIPTION")
+			for _, op := range ops {
+				entry := "running"
+				if op.EntryId != 0 {
+					entry = strconv.Itoa(op.EntryId)
+				}
+				description := ""
+				if op.Op == "start" {
+					description = describe(timeentries.GetTimeEntriesOutput{Description: op.Description, Tags: op.Tags, Billable: op.Billable}, op.Project)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", op.Op, op.At.In(e.location).Format("2006-01-02 15:04"), entry, description)
+			}
+			return w.Flush()
+		case "replay":
+			offline, err := replayQueue(e)
+			if err != nil {
+				return err
+			}
+			if offline {
+				left, err := loadQueue(path)
+				if err != nil {
+					return err
+				}
+				return fmt.Errorf("Toggl cannot be reached, %d operations are still queued", len(left))
+			}
+			if len(ops) == 0 {
+				fmt.Fprintln(e.stdout, "No queued operations")
+			}
+		case "clear":
+			if err := saveQueue(path, nil); err != nil {
+				return err
+			}
+			fmt.Fprintf(e.stdout, "Discarded %d queued operations\n", len(ops))
+		default:
+			return errUsage
+		}
+		return nil
+	}
+}
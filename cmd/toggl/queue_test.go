@@ -0,0 +1,277 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/google/go-cmp/cmp"
+)
+
+// newQueueTestEnv returns a test env keeping its queue in a temporary directory.
+func newQueueTestEnv(t *testing.T, api *fakeAPI) (*env, *bytes.Buffer, *bytes.Buffer, string) {
+	t.Helper()
+	e, stdout, stderr := newTestEnv(api)
+	dir := t.TempDir()
+	e.getenv = func(key string) string {
+		if key == "XDG_STATE_HOME" {
+			return dir
+		}
+		return ""
+	}
+	return e, stdout, stderr, queuePath(e.getenv, "")
+}
+
+func TestQueueStartStopReplay(t *testing.T) {
+	api := &fakeAPI{offline: true, responses: map[string]string{
+		"GET /api/v9/me":                          meResponse,
+		"GET /api/v9/me/projects":                 projectsResponse,
+		"POST /api/v9/workspaces/1/time_entries":  `{"id":4,"description":"Deploy","project_id":10,"duration":-1,"start":"2024-05-15T10:00:00Z","workspace_id":1}`,
+		"GET /api/v9/me/time_entries/4":           `{"id":4,"description":"Deploy","project_id":10,"duration":-1,"start":"2024-05-15T10:00:00Z","workspace_id":1}`,
+		"PUT /api/v9/workspaces/1/time_entries/4": `{"id":4,"description":"Deploy","project_id":10,"duration":1800,"start":"2024-05-15T10:00:00Z","workspace_id":1}`,
+	}}
+	e, stdout, stderr, path := newQueueTestEnv(t, api)
+
+	if code := run([]string{"start", "Deploy", "-p", "Website"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	e.now = func() time.Time { return testNow.Add(30 * time.Minute) }
+	if code := run([]string{"stop"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	// A second stop is already queued.
+	if code := run([]string{"stop"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	ops, err := loadQueue(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantOps := []queuedOp{
+		{Op: "start", At: testNow, EntryId: -1, Description: "Deploy", Project: "Website"},
+		{Op: "stop", At: testNow.Add(30 * time.Minute), EntryId: -1},
+	}
+	if !cmp.Equal(wantOps, ops) {
+		t.Errorf("diff: %v", cmp.Diff(wantOps, ops))
+	}
+
+	api.offline = false
+	if code := run([]string{"queue", "replay"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	want := `Toggl cannot be reached, queued start of "Deploy" [Website] at 2024-05-15 10:00
+Toggl cannot be reached, queued stop at 2024-05-15 10:30
+Toggl cannot be reached, already queued stop at 2024-05-15 10:30
+Replayed queued start of "Deploy" [Website] at 2024-05-15 10:00
+Replayed queued stop at 2024-05-15 10:30
+`
+	if got := stdout.String(); got != want {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+
+	started := timeentries.PostTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["POST /api/v9/workspaces/1/time_entries"], &started); err != nil {
+		t.Fatal(err)
+	}
+	if *started.Start != "2024-05-15T10:00:00Z" || started.ProjectId != 10 || started.Duration != -1 {
+		t.Errorf("unexpected start: %+v", started)
+	}
+	stopped := timeentries.PutTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["PUT /api/v9/workspaces/1/time_entries/4"], &stopped); err != nil {
+		t.Fatal(err)
+	}
+	if stopped.Stop != "2024-05-15T10:30:00Z" || stopped.Duration != 1800 {
+		t.Errorf("unexpected stop: %+v", stopped)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the replayed queue to be removed, got %v", err)
+	}
+}
+
+func TestQueueConflicts(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current": `{"id":3,"description":"Review","duration":-1,"start":"2024-05-15T10:15:00Z","workspace_id":1}`,
+	}}
+	e, _, stderr, path := newQueueTestEnv(t, api)
+	ops := []queuedOp{
+		{Op: "stop", At: testNow},
+		{Op: "start", At: testNow, EntryId: -1, Description: "Deploy"},
+		{Op: "stop", At: testNow.Add(time.Hour), EntryId: -1},
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveQueue(path, ops); err != nil {
+		t.Fatal(err)
+	}
+
+	if offline, err := replayQueue(e); offline || err != nil {
+		t.Fatalf("unexpected result: %v, %v", offline, err)
+	}
+	want := `Dropped queued stop at 2024-05-15 10:00: conflict: the running time entry 3 "Review" started at 10:15, after the stop
+Dropped queued start of "Deploy" at 2024-05-15 10:00: conflict: the running time entry 3 "Review" started at 10:15, after the start
+Dropped queued stop at 2024-05-15 11:00: conflict: the queued start of the time entry was dropped
+`
+	if got := stderr.String(); got != want {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+	if left, err := loadQueue(path); err != nil || len(left) != 0 {
+		t.Errorf("expected an empty queue, got %v, %v", left, err)
+	}
+}
+
+func TestQueueReplayStopsRunning(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me":                          meResponse,
+		"GET /api/v9/me/time_entries/current":     `{"id":3,"description":"Review","duration":-1,"start":"2024-05-15T09:00:00Z","workspace_id":1}`,
+		"PUT /api/v9/workspaces/1/time_entries/3": `{"id":3,"description":"Review","duration":3600,"start":"2024-05-15T09:00:00Z","workspace_id":1}`,
+		"POST /api/v9/workspaces/1/time_entries":  `{"id":4,"description":"Deploy","duration":-1,"start":"2024-05-15T10:00:00Z","workspace_id":1}`,
+	}}
+	e, stdout, stderr, path := newQueueTestEnv(t, api)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveQueue(path, []queuedOp{{Op: "start", At: testNow, EntryId: -1, Description: "Deploy"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if offline, err := replayQueue(e); offline || err != nil {
+		t.Fatalf("unexpected result: %v, %v: %s", offline, err, stderr)
+	}
+	if want := "Replayed queued start of \"Deploy\" at 2024-05-15 10:00\n"; stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+	// The running time entry is stopped at the queued start.
+	stopped := timeentries.PutTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["PUT /api/v9/workspaces/1/time_entries/3"], &stopped); err != nil {
+		t.Fatal(err)
+	}
+	if stopped.Stop != "2024-05-15T10:00:00Z" || stopped.Duration != 3600 {
+		t.Errorf("unexpected stop: %+v", stopped)
+	}
+	if want := []string{"GET /api/v9/me/time_entries", "GET /api/v9/me/time_entries/current", "GET /api/v9/me", "PUT /api/v9/workspaces/1/time_entries/3", "POST /api/v9/workspaces/1/time_entries"}; !cmp.Equal(want, api.requests) {
+		t.Errorf("diff: %v", cmp.Diff(want, api.requests))
+	}
+}
+
+func TestQueueReplayErrors(t *testing.T) {
+	api := &fakeAPI{
+		responses: map[string]string{
+			"GET /api/v9/me":                         meResponse,
+			"POST /api/v9/workspaces/1/time_entries": `{}`,
+		},
+		statuses: map[string]int{"POST /api/v9/workspaces/1/time_entries": http.StatusBadRequest},
+	}
+	e, _, stderr, path := newQueueTestEnv(t, api)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	ops := []queuedOp{
+		{Op: "start", At: testNow, EntryId: -1, Description: "Deploy"},
+		{Op: "stop", At: testNow.Add(time.Hour), EntryId: -1},
+	}
+	if err := saveQueue(path, ops); err != nil {
+		t.Fatal(err)
+	}
+
+	// Refused operations are dropped instead of blocking the queue.
+	if offline, err := replayQueue(e); offline || err != nil {
+		t.Fatalf("unexpected result: %v, %v", offline, err)
+	}
+	want := `Dropped queued start of "Deploy" at 2024-05-15 10:00, refused by Toggl: error response status code
+Dropped queued stop at 2024-05-15 11:00: conflict: the queued start of the time entry was dropped
+`
+	if got := stderr.String(); got != want {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+	if left, err := loadQueue(path); err != nil || len(left) != 0 {
+		t.Errorf("expected an empty queue, got %v, %v", left, err)
+	}
+
+	// Other errors keep the operation queued and name it.
+	api.responses["GET /api/v9/me/time_entries/current"] = `{"id":3,"duration":-1,"start":"soon","workspace_id":1}`
+	if err := saveQueue(path, ops[:1]); err != nil {
+		t.Fatal(err)
+	}
+	_, err := replayQueue(e)
+	if err == nil || !strings.HasPrefix(err.Error(), `replaying the queued start of "Deploy" at 2024-05-15 10:00 in `+path+": ") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if left, err := loadQueue(path); err != nil || len(left) != 1 {
+		t.Errorf("expected the start to stay queued, got %v, %v", left, err)
+	}
+}
+
+func TestQueueReplayStarted(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries":             `[{"id":4,"description":"Deploy","duration":-1,"start":"2024-05-15T10:00:00+00:00","workspace_id":1}]`,
+		"GET /api/v9/me/time_entries/4":           `{"id":4,"description":"Deploy","duration":-1,"start":"2024-05-15T10:00:00+00:00","workspace_id":1}`,
+		"PUT /api/v9/workspaces/1/time_entries/4": `{"id":4,"description":"Deploy","duration":3600,"start":"2024-05-15T10:00:00Z","workspace_id":1}`,
+	}}
+	e, _, stderr, path := newQueueTestEnv(t, api)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	ops := []queuedOp{
+		{Op: "start", At: testNow, EntryId: -1, Description: "Deploy"},
+		{Op: "stop", At: testNow.Add(time.Hour), EntryId: -1},
+	}
+	if err := saveQueue(path, ops); err != nil {
+		t.Fatal(err)
+	}
+
+	// A start that was already replayed is not created again, and the stop applies to it.
+	if offline, err := replayQueue(e); offline || err != nil {
+		t.Fatalf("unexpected result: %v, %v: %s", offline, err, stderr)
+	}
+	if want := []string{"GET /api/v9/me/time_entries", "GET /api/v9/me/time_entries/4", "PUT /api/v9/workspaces/1/time_entries/4"}; !cmp.Equal(want, api.requests) {
+		t.Errorf("diff: %v", cmp.Diff(want, api.requests))
+	}
+}
+
+func TestQueueReplayUnavailable(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		api := &fakeAPI{
+			responses: map[string]string{"GET /api/v9/me/time_entries/current": `{}`},
+			statuses:  map[string]int{"GET /api/v9/me/time_entries/current": status},
+		}
+		e, _, _, path := newQueueTestEnv(t, api)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := saveQueue(path, []queuedOp{{Op: "stop", At: testNow, EntryId: 0}}); err != nil {
+			t.Fatal(err)
+		}
+		// Rate limits and server errors keep the operation queued for a later replay.
+		if offline, err := replayQueue(e); !offline || err != nil {
+			t.Errorf("%d: unexpected result: %v, %v", status, offline, err)
+		}
+		if left, err := loadQueue(path); err != nil || len(left) != 1 {
+			t.Errorf("%d: expected the stop to stay queued, got %v, %v", status, left, err)
+		}
+	}
+}
+
+func TestQueueReplayOffline(t *testing.T) {
+	api := &fakeAPI{offline: true, responses: map[string]string{}}
+	e, _, stderr, path := newQueueTestEnv(t, api)
+	if code := run([]string{"stop"}, e); code != 0 {
+		t.Fatal("expected the stop to be queued")
+	}
+	if code := run([]string{"queue", "replay"}, e); code != 1 {
+		t.Fatalf("Expected exit code 1, got %d", code)
+	}
+	want := "toggl queue: Toggl cannot be reached, 1 operations are still queued\n"
+	if got := stderr.String(); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if ops, err := loadQueue(path); err != nil || len(ops) != 1 {
+		t.Errorf("expected the stop to stay queued, got %v, %v", ops, err)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode/utf8"
+)
+
+// terminal is the terminal of the dashboard, switched to raw mode with stty.
+type terminal struct {
+	in    *os.File
+	saved string // Settings restored on exit
+}
+
+// openTerminal switches the terminal read from in to raw mode.
+func openTerminal(in io.Reader) (*terminal, error) {
+	f, ok := in.(*os.File)
+	if !ok {
+		return nil, errors.New("the dashboard needs a terminal")
+	}
+	t := &terminal{in: f}
+	saved, err := t.stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("the dashboard needs a terminal and stty: %w", err)
+	}
+	t.saved = strings.TrimSpace(saved)
+	if _, err := t.stty("raw", "-echo"); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *terminal) stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = t.in
+	out, err := cmd.Output()
+	return string(out), err
+}
+
+// restore restores the settings of the terminal.
+func (t *terminal) restore() error {
+	_, err := t.stty(t.saved)
+	return err
+}
+
+// size returns the number of rows and columns of the terminal.
+func (t *terminal) size() (int, int, error) {
+	out, err := t.stty("size")
+	if err != nil {
+		return 0, 0, err
+	}
+	var rows, cols int
+	if _, err := fmt.Sscan(out, &rows, &cols); err != nil {
+		return 0, 0, err
+	}
+	return rows, cols, nil
+}
+
+// keys returns the keys read from the terminal. The channel is closed when reading fails.
+func (t *terminal) keys() <-chan string {
+	keys := make(chan string)
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 256)
+		for {
+			n, err := t.in.Read(buf)
+			for _, key := range parseKeys(buf[:n]) {
+				keys <- key
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return keys
+}
+
+// escapeKeys are the names of the escape sequences of special keys.
+var escapeKeys = map[string]string{
+	"\x1b[A": "up", "\x1b[B": "down", "\x1b[C": "right", "\x1b[D": "left",
+	"\x1bOA": "up", "\x1bOB": "down", "\x1bOC": "right", "\x1bOD": "left",
+	"\x1b[H": "home", "\x1b[F": "end", "\x1b[1~": "home", "\x1b[4~": "end",
+	"\x1b[5~": "pgup", "\x1b[6~": "pgdown", "\x1b[3~": "delete",
+}
+
+// parseKeys splits the input read from a terminal in raw mode into keys. Special keys
+// are named like "up", "enter" or "ctrl-c", other keys are the characters they type.
+func parseKeys(b []byte) []string {
+	var keys []string
+	s := string(b)
+	for len(s) > 0 {
+		if s[0] == '\x1b' {
+			matched := false
+			for seq, name := range escapeKeys {
+				if strings.HasPrefix(s, seq) {
+					keys, s, matched = append(keys, name), s[len(seq):], true
+					break
+				}
+			}
+			if !matched {
+				keys, s = append(keys, "esc"), s[1:]
+			}
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s)
+		switch r {
+		case '\r', '\n':
+			keys = append(keys, "enter")
+		case '\t':
+			keys = append(keys, "tab")
+		case 0x7f, '\b':
+			keys = append(keys, "backspace")
+		default:
+			if r > 0 && r < 0x20 {
+				keys = append(keys, "ctrl-"+string(rune('a'+r-1)))
+			} else {
+				keys = append(keys, string(r))
+			}
+		}
+		s = s[size:]
+	}
+	return keys
+}
+
+// readSecret reads a line from in. On a terminal, it shows the prompt on out and does not echo the line.
+func readSecret(in io.Reader, out io.Writer, prompt string) (string, error) {
+	if f, ok := in.(*os.File); ok {
+		t := &terminal{in: f}
+		if saved, err := t.stty("-g"); err == nil {
+			t.saved = strings.TrimSpace(saved)
+			if _, err := t.stty("-echo"); err != nil {
+				return "", err
+			}
+			defer t.restore()
+			io.WriteString(out, prompt)
+			defer io.WriteString(out, "\n")
+		}
+	}
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
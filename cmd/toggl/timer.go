@@ -0,0 +1,260 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+// stringList is a flag holding comma separated values, which may be repeated.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+// entryFlags are the flags describing a new time entry.
+type entryFlags struct {
+	workspace int
+	project   string
+	tags      stringList
+	billable  bool
+}
+
+func (f *entryFlags) bind(fs *flag.FlagSet) {
+	fs.IntVar(&f.workspace, "workspace", 0, "workspace `ID`, defaults to the default workspace of the user")
+	fs.StringVar(&f.project, "project", "", "project `name or ID`")
+	fs.StringVar(&f.project, "p", "", "shorthand for -project")
+	fs.Var(&f.tags, "tags", "comma separated tag `names`, may be repeated")
+	fs.Var(&f.tags, "t", "shorthand for -tags")
+	fs.BoolVar(&f.billable, "billable", false, "mark the time entry as billable")
+	fs.BoolVar(&f.billable, "b", false, "shorthand for -billable")
+}
+
+func setupStart(fs *flag.FlagSet) func(e *env, args []string) error {
+	var f entryFlags
+	f.bind(fs)
+	return func(e *env, args []string) error {
+		description := strings.Join(args, " ")
+		offline, err := replayQueue(e)
+		if err != nil {
+			return err
+		}
+		// Once something is queued, starts are queued behind it to keep the order.
+		if !offline {
+			te, err := startFromFlags(e, f, description)
+			if !isOffline(err) {
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(e.stdout, "Started %s at %s\n", describe(te, e.projectName(te.ProjectId)), e.now().In(e.location).Format("15:04"))
+				return nil
+			}
+		}
+		return queueStart(e, queuedOp{Description: description, Project: f.project, Tags: f.tags, Billable: f.billable, WorkspaceId: f.workspace})
+	}
+}
+
+// startFromFlags starts a time entry with the description and the flags.
+func startFromFlags(e *env, f entryFlags, description string) (timeentries.PostTimeEntriesOutput, error) {
+	workspaceId, err := e.workspaceId(f.workspace)
+	if err != nil {
+		return timeentries.PostTimeEntriesOutput{}, err
+	}
+	projectId, err := e.resolveProject(workspaceId, f.project)
+	if err != nil {
+		return timeentries.PostTimeEntriesOutput{}, err
+	}
+	return startEntry(e, timeentries.PostTimeEntriesBody{
+		Billable:    f.billable,
+		Description: description,
+		ProjectId:   projectId,
+		Tags:        f.tags,
+		WorkspaceId: workspaceId,
+	})
+}
+
+// startEntry creates a running time entry from body, starting now.
+func startEntry(e *env, body timeentries.PostTimeEntriesBody) (timeentries.PostTimeEntriesOutput, error) {
+	return startEntryAt(e, body, e.now())
+}
+
+// startEntryAt creates a time entry from body, running since start.
+func startEntryAt(e *env, body timeentries.PostTimeEntriesBody, start time.Time) (timeentries.PostTimeEntriesOutput, error) {
+	c, err := e.togglClient()
+	if err != nil {
+		return timeentries.PostTimeEntriesOutput{}, err
+	}
+	s := start.UTC().Format(time.RFC3339)
+	body.CreatedWith = createdWith
+	body.Duration = -1
+	body.Start = &s
+	return c.TimeEntriesClient.PostTimeEntries(timeentries.PostTimeEntriesInput{
+		WorkspaceId: body.WorkspaceId,
+		Body:        body,
+	})
+}
+
+func setupStop(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) > 0 {
+			return errUsage
+		}
+		offline, err := replayQueue(e)
+		if err != nil {
+			return err
+		}
+		if offline {
+			return queueStop(e)
+		}
+		te, err := stopRunning(e)
+		if isOffline(err) {
+			return queueStop(e)
+		}
+		if err != nil {
+			return err
+		}
+		if te.Id == 0 {
+			fmt.Fprintln(e.stdout, "No time entry is running")
+			return nil
+		}
+		fmt.Fprintf(e.stdout, "Stopped %s\n", describeStopped(e, te))
+		return nil
+	}
+}
+
+// stopRunning stops the running time entry, if any.
+// It returns the stopped time entry, or an empty one if none was running.
+func stopRunning(e *env) (timeentries.PatchStopTimeEntryOutput, error) {
+	c, err := e.togglClient()
+	if err != nil {
+		return timeentries.PatchStopTimeEntryOutput{}, err
+	}
+	current, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+	if err != nil || current.Id == 0 {
+		return timeentries.PatchStopTimeEntryOutput{}, err
+	}
+	te, err := c.TimeEntriesClient.PatchStopTimeEntry(timeentries.PatchStopTimeEntryInput{
+		WorkspaceId: current.WorkspaceId,
+		TimeEntryId: current.Id,
+	})
+	if err != nil {
+		return timeentries.PatchStopTimeEntryOutput{}, err
+	}
+	return te, nil
+}
+
+// describeStopped describes the stopped time entry with its duration.
+func describeStopped(e *env, te timeentries.GetTimeEntriesOutput) string {
+	return fmt.Sprintf("%s after %s", describe(te, e.projectName(te.ProjectId)), formatElapsed(elapsed(te, e.now())))
+}
+
+func setupStatus(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) > 0 {
+			return errUsage
+		}
+		c, err := e.togglClient()
+		if err != nil {
+			return err
+		}
+		current, err := c.TimeEntriesClient.GetCurrentTimeEntry()
+		if err != nil {
+			return err
+		}
+		if current.Id == 0 {
+			fmt.Fprintln(e.stdout, "No time entry is running")
+			return nil
+		}
+		start, err := entryStart(current, e.location)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(e.stdout, "Running %s\n", describe(current, e.projectName(current.ProjectId)))
+		fmt.Fprintf(e.stdout, "Started at %s, elapsed %s\n", start.Format("15:04"), formatElapsed(elapsed(current, e.now())))
+		return nil
+	}
+}
+
+func setupContinue(fs *flag.FlagSet) func(e *env, args []string) error {
+	return func(e *env, args []string) error {
+		if len(args) > 1 {
+			return errUsage
+		}
+		c, err := e.togglClient()
+		if err != nil {
+			return err
+		}
+
+		var last timeentries.GetTimeEntriesOutput
+		if len(args) == 1 {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return errUsage
+			}
+			if last, err = c.TimeEntriesClient.GetATimeEntryById(timeentries.GetATimeEntryByIdInput{TimeEntryId: id}); err != nil {
+				return err
+			}
+			if last.Id == 0 {
+				return fmt.Errorf("time entry %d not found", id)
+			}
+		} else {
+			entries, err := c.TimeEntriesClient.GetTimeEntries(timeentries.GetTimeEntriesInput{})
+			if err != nil {
+				return err
+			}
+			// Time entries are returned newest first.
+			for _, te := range entries {
+				if te.Duration >= 0 {
+					last = te
+					break
+				}
+			}
+			if last.Id == 0 {
+				return errors.New("no time entry to continue")
+			}
+		}
+
+		stopped, te, err := continueEntry(e, last)
+		if err != nil {
+			return err
+		}
+		if stopped.Id != 0 {
+			fmt.Fprintf(e.stdout, "Stopped %s\n", describeStopped(e, stopped))
+		}
+		fmt.Fprintf(e.stdout, "Continued %s at %s\n", describe(te, e.projectName(te.ProjectId)), e.now().In(e.location).Format("15:04"))
+		return nil
+	}
+}
+
+// continueEntry stops the running time entry, if any, and starts a new one like last.
+// It returns the stopped time entry, or an empty one if none was running, and the started one.
+func continueEntry(e *env, last timeentries.GetTimeEntriesOutput) (timeentries.PatchStopTimeEntryOutput, timeentries.PostTimeEntriesOutput, error) {
+	stopped, err := stopRunning(e)
+	if err != nil {
+		return timeentries.PatchStopTimeEntryOutput{}, timeentries.PostTimeEntriesOutput{}, err
+	}
+	te, err := startEntry(e, timeentries.PostTimeEntriesBody{
+		Billable:    last.Billable,
+		Description: last.Description,
+		ProjectId:   last.ProjectId,
+		TagIds:      last.TagIds,
+		TaskId:      last.TaskId,
+		WorkspaceId: last.WorkspaceId,
+	})
+	return stopped, te, err
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	meResponse       = `{"id":1,"default_workspace_id":1}`
+	projectsResponse = `[{"id":10,"name":"Website","workspace_id":1},{"id":20,"name":"Website","workspace_id":2}]`
+)
+
+func TestFormatElapsed(t *testing.T) {
+	test := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0:00:00"},
+		{59 * time.Second, "0:00:59"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "1:02:03"},
+		{25 * time.Hour, "25:00:00"},
+		{-time.Second, "0:00:00"},
+	}
+	for _, tt := range test {
+		if got := formatElapsed(tt.d); got != tt.want {
+			t.Errorf("formatElapsed(%v): expected %q, got %q", tt.d, tt.want, got)
+		}
+	}
+}
+
+func TestStart(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me":                         meResponse,
+		"GET /api/v9/me/projects":                projectsResponse,
+		"POST /api/v9/workspaces/1/time_entries": `{"id":100,"description":"Write docs","project_id":10,"tags":["docs","web"],"workspace_id":1}`,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+
+	if code := run([]string{"start", "Write", "docs", "-p", "website", "-t", "docs,web", "-b"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	if want := "Started \"Write docs\" [Website] #docs #web at 10:00\n"; stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+
+	got := timeentries.PostTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["POST /api/v9/workspaces/1/time_entries"], &got); err != nil {
+		t.Fatal(err)
+	}
+	start := testNow.UTC().Format(time.RFC3339)
+	want := timeentries.PostTimeEntriesBody{
+		Billable:    true,
+		CreatedWith: createdWith,
+		Description: "Write docs",
+		Duration:    -1,
+		ProjectId:   10,
+		Start:       &start,
+		Tags:        []string{"docs", "web"},
+		WorkspaceId: 1,
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+}
+
+func TestStartUnknownProject(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/projects": projectsResponse,
+	}}
+	e, _, stderr := newTestEnv(api)
+
+	if code := run([]string{"start", "-workspace", "3", "-p", "website"}, e); code != 1 {
+		t.Fatalf("Expected exit code 1, got %d", code)
+	}
+	if want := "toggl start: unknown project \"website\"\n"; stderr.String() != want {
+		t.Errorf("Expected %q, got %q", want, stderr.String())
+	}
+}
+
+func TestStatus(t *testing.T) {
+	running := testNow.Add(-(time.Hour + 2*time.Minute + 3*time.Second)).UTC().Format(time.RFC3339)
+	test := []struct {
+		name    string
+		current string
+		want    string
+	}{
+		{"running", `{"id":100,"description":"Write docs","project_id":10,"duration":-1,"start":"` + running + `","workspace_id":1}`,
+			"Running \"Write docs\" [Website]\nStarted at 08:57, elapsed 1:02:03\n"},
+		{"not running", `null`, "No time entry is running\n"},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{responses: map[string]string{
+				"GET /api/v9/me/time_entries/current": tt.current,
+				"GET /api/v9/me/projects":             projectsResponse,
+			}}
+			e, stdout, stderr := newTestEnv(api)
+			if code := run([]string{"status"}, e); code != 0 {
+				t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, stdout.String())
+			}
+		})
+	}
+}
+
+func TestStop(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries/current":              `{"id":100,"duration":-1,"workspace_id":1}`,
+		"PATCH /api/v9/workspaces/1/time_entries/100/stop": `{"id":100,"description":"Write docs","duration":3723,"workspace_id":1}`,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+
+	if code := run([]string{"stop"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	if want := "Stopped \"Write docs\" after 1:02:03\n"; stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+}
+
+func TestContinue(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{
+		"GET /api/v9/me/time_entries": `[
+			{"id":101,"description":"Running","duration":-1,"workspace_id":1},
+			{"id":100,"description":"Write docs","project_id":10,"tag_ids":[5],"billable":true,"duration":3600,"workspace_id":1}
+		]`,
+		"GET /api/v9/me/time_entries/current":              `{"id":101,"duration":-1,"workspace_id":1}`,
+		"PATCH /api/v9/workspaces/1/time_entries/101/stop": `{"id":101,"description":"Running","duration":60,"workspace_id":1}`,
+		"POST /api/v9/workspaces/1/time_entries":           `{"id":102,"description":"Write docs","project_id":10,"billable":true,"workspace_id":1}`,
+		"GET /api/v9/me/projects":                          projectsResponse,
+	}}
+	e, stdout, stderr := newTestEnv(api)
+
+	if code := run([]string{"continue"}, e); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	want := "Stopped \"Running\" after 0:01:00\nContinued \"Write docs\" [Website] $ at 10:00\n"
+	if stdout.String() != want {
+		t.Errorf("Expected %q, got %q", want, stdout.String())
+	}
+
+	got := timeentries.PostTimeEntriesBody{}
+	if err := json.Unmarshal(api.bodies["POST /api/v9/workspaces/1/time_entries"], &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Description != "Write docs" || got.ProjectId != 10 || !got.Billable || !cmp.Equal([]int{5}, got.TagIds) {
+		t.Errorf("unexpected body: %+v", got)
+	}
+}
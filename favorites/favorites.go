@@ -0,0 +1,224 @@
+package favorites
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	favoritesPath = "/api/v9/me/favorites"
+	favoritePath  = "/api/v9/me/favorites/%d"
+)
+
+// GetFavoritesOutput represents a favorite of the current user, used as a template to start time entries.
+type GetFavoritesOutput struct {
+	Billable     bool     `json:"billable"`
+	ClientName   string   `json:"client_name"`
+	CreatedAt    string   `json:"created_at"`
+	DeletedAt    *string  `json:"deleted_at"`
+	Description  string   `json:"description"`
+	FavoriteId   int      `json:"favorite_id"`
+	ProjectColor string   `json:"project_color"`
+	ProjectId    int      `json:"project_id"`
+	ProjectName  string   `json:"project_name"`
+	Public       bool     `json:"public"`
+	Rank         int      `json:"rank"`
+	TagIds       []int    `json:"tag_ids"`
+	Tags         []string `json:"tags"`
+	TaskId       int      `json:"task_id"`
+	TaskName     string   `json:"task_name"`
+	UserId       int      `json:"user_id"`
+	WorkspaceId  int      `json:"workspace_id"`
+}
+
+// GetFavorites retrieves the favorites of the current user.
+func (c Client) GetFavorites() ([]GetFavoritesOutput, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = favoritesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetFavoritesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gfo := make([]GetFavoritesOutput, 0)
+	if err := json.Unmarshal(body, &gfo); err != nil {
+		return nil, err
+	}
+
+	return gfo, nil
+}
+
+// PostFavoriteBody represents the body of the request to create a favorite.
+type PostFavoriteBody struct {
+	Billable    bool   `json:"billable,omitempty"`    // Whether time entries started from the favorite are billable
+	Description string `json:"description,omitempty"` // Description of time entries started from the favorite
+	ProjectId   int    `json:"project_id,omitempty"`  // Project ID, optional
+	Public      bool   `json:"public,omitempty"`      // Whether the favorite is shared with the workspace
+	Rank        int    `json:"rank,omitempty"`        // Position of the favorite in the list
+	TagIds      []int  `json:"tag_ids,omitempty"`     // Tag IDs, optional
+	TaskId      int    `json:"task_id,omitempty"`     // Task ID, optional
+	WorkspaceId int    `json:"workspace_id"`          // Workspace ID, required
+}
+
+// PostFavoriteInput contains the input data for PostFavorite.
+type PostFavoriteInput struct {
+	Body PostFavoriteBody
+}
+
+// PostFavoriteOutput represents the response after creating a favorite.
+type PostFavoriteOutput = GetFavoritesOutput
+
+// PostFavorite creates a new favorite for the current user.
+func (c Client) PostFavorite(input PostFavoriteInput) (PostFavoriteOutput, error) {
+	if input.Body.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostFavoriteOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostFavoriteOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = favoritesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostFavoriteOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostFavoriteOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostFavoriteOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostFavoriteOutput{}, ErrorStatusNotOK
+	}
+
+	pfo := PostFavoriteOutput{}
+	if err := json.Unmarshal(body, &pfo); err != nil {
+		return PostFavoriteOutput{}, err
+	}
+
+	return pfo, nil
+}
+
+// PutFavoriteBody represents the body of the request to update a favorite.
+type PutFavoriteBody struct {
+	Billable    *bool   `json:"billable,omitempty"`    // Whether time entries started from the favorite are billable
+	Description *string `json:"description,omitempty"` // Description of time entries started from the favorite
+	FavoriteId  int     `json:"favorite_id"`           // Favorite ID, required
+	ProjectId   *int    `json:"project_id,omitempty"`  // Project ID
+	Public      *bool   `json:"public,omitempty"`      // Whether the favorite is shared with the workspace
+	Rank        *int    `json:"rank,omitempty"`        // Position of the favorite in the list
+	TagIds      []int   `json:"tag_ids,omitempty"`     // Tag IDs
+	TaskId      *int    `json:"task_id,omitempty"`     // Task ID
+}
+
+// PutFavoriteInput contains the input data for PutFavorite.
+type PutFavoriteInput struct {
+	Body PutFavoriteBody
+}
+
+// PutFavoriteOutput represents the response after updating a favorite.
+type PutFavoriteOutput = GetFavoritesOutput
+
+// PutFavorite updates an existing favorite of the current user.
+func (c Client) PutFavorite(input PutFavoriteInput) (PutFavoriteOutput, error) {
+	if input.Body.FavoriteId == 0 {
+		slog.Error("FavoriteId is required")
+		return PutFavoriteOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutFavoriteOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = favoritesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutFavoriteOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutFavoriteOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutFavoriteOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutFavoriteOutput{}, ErrorStatusNotOK
+	}
+
+	pfo := PutFavoriteOutput{}
+	if err := json.Unmarshal(body, &pfo); err != nil {
+		return PutFavoriteOutput{}, err
+	}
+
+	return pfo, nil
+}
+
+// DeleteFavoriteInput contains the input data for DeleteFavorite.
+type DeleteFavoriteInput struct {
+	FavoriteId int // required
+}
+
+// DeleteFavorite deletes a favorite of the current user.
+func (c Client) DeleteFavorite(input DeleteFavoriteInput) error {
+	if input.FavoriteId == 0 {
+		slog.Error("FavoriteId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(favoritePath, input.FavoriteId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
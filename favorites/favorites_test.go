@@ -0,0 +1,181 @@
+package favorites_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/favorites"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) favorites.Client {
+	return favorites.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetFavorites(t *testing.T) {
+	testFile := readTestFile(t, "testdata/favorites/favorites.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   favorites.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, favorites.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetFavorites()
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostFavorite(t *testing.T) {
+	testFile := readTestFile(t, "testdata/favorites/favorite.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(favorites.PostFavoriteOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := favorites.PostFavoriteBody{Description: "description", WorkspaceId: 123456789}
+	test := []struct {
+		name     string
+		client   favorites.Client
+		arg      favorites.PostFavoriteInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, favorites.PostFavoriteInput{Body: body}, testFile, nil},
+		{"parameter error", successClient, favorites.PostFavoriteInput{}, errorWant, favorites.ErrorRequiredParameter},
+		{"http error", errorClient, favorites.PostFavoriteInput{Body: body}, errorWant, favorites.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostFavorite(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutFavorite(t *testing.T) {
+	testFile := readTestFile(t, "testdata/favorites/favorite.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(favorites.PutFavoriteOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := favorites.PutFavoriteBody{FavoriteId: 1234567890}
+	test := []struct {
+		name     string
+		client   favorites.Client
+		arg      favorites.PutFavoriteInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, favorites.PutFavoriteInput{Body: body}, testFile, nil},
+		{"parameter error", successClient, favorites.PutFavoriteInput{}, errorWant, favorites.ErrorRequiredParameter},
+		{"http error", errorClient, favorites.PutFavoriteInput{Body: body}, errorWant, favorites.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutFavorite(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteFavorite(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	favoriteId := 1234567890
+	test := []struct {
+		name    string
+		client  favorites.Client
+		arg     favorites.DeleteFavoriteInput
+		wantErr error
+	}{
+		{"success", successClient, favorites.DeleteFavoriteInput{FavoriteId: favoriteId}, nil},
+		{"parameter error", successClient, favorites.DeleteFavoriteInput{}, favorites.ErrorRequiredParameter},
+		{"http error", errorClient, favorites.DeleteFavoriteInput{FavoriteId: favoriteId}, favorites.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteFavorite(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
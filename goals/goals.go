@@ -0,0 +1,302 @@
+package goals
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	goalsPath = "/api/v9/workspaces/%d/goals"
+	goalPath  = "/api/v9/workspaces/%d/goals/%d"
+)
+
+// Comparison represents how the tracked time is compared with the target of a goal.
+type Comparison string
+
+const (
+	ComparisonGreaterOrEqual Comparison = "gte" // Track at least the target
+	ComparisonLessOrEqual    Comparison = "lte" // Track at most the target
+)
+
+// Recurrence represents the period after which the progress of a goal is reset.
+type Recurrence string
+
+const (
+	RecurrenceDaily   Recurrence = "daily"
+	RecurrenceWeekly  Recurrence = "weekly"
+	RecurrenceMonthly Recurrence = "monthly"
+)
+
+// GetGoalsQuery represents the query parameters for fetching goals.
+type GetGoalsQuery struct {
+	UserId    *int  // Goals of this user
+	CreatorId *int  // Goals created by this user
+	TeamGoal  *bool // Only team goals
+	Active    *bool // Only active goals
+	Page      *int  // Page number
+	PerPage   *int  // Number of items per page
+}
+
+// GetGoalsInput contains the input data for GetGoals.
+type GetGoalsInput struct {
+	WorkspaceId int // required
+	Query       GetGoalsQuery
+}
+
+// GetGoalsOutput represents a goal of a workspace.
+type GetGoalsOutput struct {
+	Active                          bool       `json:"active"`
+	Billable                        bool       `json:"billable"`
+	Comparison                      Comparison `json:"comparison"`
+	CreatedAt                       string     `json:"created_at"`
+	CreatorId                       int        `json:"creator_id"`
+	CreatorName                     string     `json:"creator_name"`
+	CurrentRecurrenceEndDate        string     `json:"current_recurrence_end_date"`
+	CurrentRecurrenceStartDate      string     `json:"current_recurrence_start_date"`
+	CurrentRecurrenceTrackedSeconds int        `json:"current_recurrence_tracked_seconds"`
+	EndDate                         *string    `json:"end_date"`
+	GoalId                          int        `json:"goal_id"`
+	Icon                            string     `json:"icon"`
+	Name                            string     `json:"name"`
+	ProjectIds                      []int      `json:"project_ids"`
+	Recurrence                      Recurrence `json:"recurrence"`
+	StartDate                       string     `json:"start_date"`
+	TagIds                          []int      `json:"tag_ids"`
+	TargetSeconds                   int        `json:"target_seconds"`
+	TaskIds                         []int      `json:"task_ids"`
+	TeamGoal                        bool       `json:"team_goal"`
+	UpdatedAt                       string     `json:"updated_at"`
+	UserId                          int        `json:"user_id"`
+	UserName                        string     `json:"user_name"`
+	WorkspaceId                     int        `json:"workspace_id"`
+}
+
+// GetGoals retrieves the goals of a workspace.
+func (c Client) GetGoals(input GetGoalsInput) ([]GetGoalsOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	ggq := input.Query
+	q := url.Values{}
+	if ggq.UserId != nil {
+		q.Add("user_id", fmt.Sprintf("%v", *ggq.UserId))
+	}
+	if ggq.CreatorId != nil {
+		q.Add("creator_id", fmt.Sprintf("%v", *ggq.CreatorId))
+	}
+	if ggq.TeamGoal != nil {
+		q.Add("team_goal", fmt.Sprintf("%v", *ggq.TeamGoal))
+	}
+	if ggq.Active != nil {
+		q.Add("active", fmt.Sprintf("%v", *ggq.Active))
+	}
+	if ggq.Page != nil {
+		q.Add("page", fmt.Sprintf("%v", *ggq.Page))
+	}
+	if ggq.PerPage != nil {
+		q.Add("per_page", fmt.Sprintf("%v", *ggq.PerPage))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(goalsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetGoalsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	ggo := make([]GetGoalsOutput, 0)
+	if err := json.Unmarshal(body, &ggo); err != nil {
+		return nil, err
+	}
+
+	return ggo, nil
+}
+
+// PostGoalBody represents the body of the request to create a goal.
+type PostGoalBody struct {
+	Billable      bool       `json:"billable,omitempty"`    // Only count billable time
+	Comparison    Comparison `json:"comparison"`            // Comparison with the target, required
+	EndDate       *string    `json:"end_date,omitempty"`    // Last day of the goal, YYYY-MM-DD, optional
+	Icon          string     `json:"icon,omitempty"`        // Icon of the goal
+	Name          string     `json:"name"`                  // Name of the goal, required
+	ProjectIds    []int      `json:"project_ids,omitempty"` // Only count time tracked to these projects
+	Recurrence    Recurrence `json:"recurrence"`            // Recurrence of the goal, required
+	StartDate     string     `json:"start_date"`            // First day of the goal, YYYY-MM-DD, required
+	TagIds        []int      `json:"tag_ids,omitempty"`     // Only count time entries with these tags
+	TargetSeconds int        `json:"target_seconds"`        // Target of the goal in seconds, required
+	TaskIds       []int      `json:"task_ids,omitempty"`    // Only count time tracked to these tasks
+	UserId        int        `json:"user_id,omitempty"`     // User the goal is assigned to, defaults to the requester
+}
+
+// PostGoalInput contains the input data for PostGoal.
+type PostGoalInput struct {
+	WorkspaceId int // required
+	Body        PostGoalBody
+}
+
+// PostGoalOutput represents the response after creating a goal.
+type PostGoalOutput = GetGoalsOutput
+
+// PostGoal creates a new goal in a workspace.
+func (c Client) PostGoal(input PostGoalInput) (PostGoalOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostGoalOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostGoalOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(goalsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostGoalOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostGoalOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostGoalOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostGoalOutput{}, ErrorStatusNotOK
+	}
+
+	pgo := PostGoalOutput{}
+	if err := json.Unmarshal(body, &pgo); err != nil {
+		return PostGoalOutput{}, err
+	}
+
+	return pgo, nil
+}
+
+// PutGoalBody represents the body of the request to update a goal.
+type PutGoalBody struct {
+	Comparison    Comparison `json:"comparison,omitempty"`     // Comparison with the target
+	EndDate       *string    `json:"end_date,omitempty"`       // Last day of the goal, YYYY-MM-DD
+	Icon          string     `json:"icon,omitempty"`           // Icon of the goal
+	Name          string     `json:"name,omitempty"`           // Name of the goal
+	Recurrence    Recurrence `json:"recurrence,omitempty"`     // Recurrence of the goal
+	TargetSeconds int        `json:"target_seconds,omitempty"` // Target of the goal in seconds
+}
+
+// PutGoalInput contains the input data for PutGoal.
+type PutGoalInput struct {
+	WorkspaceId int // required
+	GoalId      int // required
+	Body        PutGoalBody
+}
+
+// PutGoalOutput represents the response after updating a goal.
+type PutGoalOutput = GetGoalsOutput
+
+// PutGoal updates an existing goal.
+func (c Client) PutGoal(input PutGoalInput) (PutGoalOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutGoalOutput{}, ErrorRequiredParameter
+	}
+	if input.GoalId == 0 {
+		slog.Error("GoalId is required")
+		return PutGoalOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutGoalOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(goalPath, input.WorkspaceId, input.GoalId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutGoalOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutGoalOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutGoalOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutGoalOutput{}, ErrorStatusNotOK
+	}
+
+	pgo := PutGoalOutput{}
+	if err := json.Unmarshal(body, &pgo); err != nil {
+		return PutGoalOutput{}, err
+	}
+
+	return pgo, nil
+}
+
+// DeleteGoalInput contains the input data for DeleteGoal.
+type DeleteGoalInput struct {
+	WorkspaceId int // required
+	GoalId      int // required
+}
+
+// DeleteGoal deletes a goal.
+func (c Client) DeleteGoal(input DeleteGoalInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.GoalId == 0 {
+		slog.Error("GoalId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(goalPath, input.WorkspaceId, input.GoalId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
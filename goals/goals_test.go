@@ -0,0 +1,188 @@
+package goals_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/goals"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) goals.Client {
+	return goals.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetGoals(t *testing.T) {
+	testFile := readTestFile(t, "testdata/goals/goals.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   goals.Client
+		arg      goals.GetGoalsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, goals.GetGoalsInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, goals.GetGoalsInput{}, errorWant, goals.ErrorRequiredParameter},
+		{"http error", errorClient, goals.GetGoalsInput{WorkspaceId: workspaceId}, errorWant, goals.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetGoals(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostGoal(t *testing.T) {
+	testFile := readTestFile(t, "testdata/goals/goal.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(goals.PostGoalOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   goals.Client
+		arg      goals.PostGoalInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, goals.PostGoalInput{WorkspaceId: workspaceId, Body: goals.PostGoalBody{Comparison: goals.ComparisonGreaterOrEqual, Name: "name", Recurrence: goals.RecurrenceWeekly, StartDate: "2024-01-01", TargetSeconds: 36000}}, testFile, nil},
+		{"parameter error", successClient, goals.PostGoalInput{Body: goals.PostGoalBody{Comparison: goals.ComparisonGreaterOrEqual, Name: "name", Recurrence: goals.RecurrenceWeekly, StartDate: "2024-01-01", TargetSeconds: 36000}}, errorWant, goals.ErrorRequiredParameter},
+		{"http error", errorClient, goals.PostGoalInput{WorkspaceId: workspaceId, Body: goals.PostGoalBody{Comparison: goals.ComparisonGreaterOrEqual, Name: "name", Recurrence: goals.RecurrenceWeekly, StartDate: "2024-01-01", TargetSeconds: 36000}}, errorWant, goals.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostGoal(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutGoal(t *testing.T) {
+	testFile := readTestFile(t, "testdata/goals/goal.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(goals.PutGoalOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	goalId := 1234567890
+	test := []struct {
+		name     string
+		client   goals.Client
+		arg      goals.PutGoalInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, goals.PutGoalInput{WorkspaceId: workspaceId, GoalId: goalId, Body: goals.PutGoalBody{TargetSeconds: 72000}}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, goals.PutGoalInput{GoalId: goalId, Body: goals.PutGoalBody{TargetSeconds: 72000}}, errorWant, goals.ErrorRequiredParameter},
+		{"GoalId parameter error", successClient, goals.PutGoalInput{WorkspaceId: workspaceId, Body: goals.PutGoalBody{TargetSeconds: 72000}}, errorWant, goals.ErrorRequiredParameter},
+		{"http error", errorClient, goals.PutGoalInput{WorkspaceId: workspaceId, GoalId: goalId, Body: goals.PutGoalBody{TargetSeconds: 72000}}, errorWant, goals.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutGoal(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteGoal(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	goalId := 1234567890
+	test := []struct {
+		name    string
+		client  goals.Client
+		arg     goals.DeleteGoalInput
+		wantErr error
+	}{
+		{"success", successClient, goals.DeleteGoalInput{WorkspaceId: workspaceId, GoalId: goalId}, nil},
+		{"WorkspaceId parameter error", successClient, goals.DeleteGoalInput{GoalId: goalId}, goals.ErrorRequiredParameter},
+		{"GoalId parameter error", successClient, goals.DeleteGoalInput{WorkspaceId: workspaceId}, goals.ErrorRequiredParameter},
+		{"http error", errorClient, goals.DeleteGoalInput{WorkspaceId: workspaceId, GoalId: goalId}, goals.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteGoal(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
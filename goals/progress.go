@@ -0,0 +1,115 @@
+package goals
+
+import (
+	"slices"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+const dateLayout = "2006-01-02"
+
+// Progress represents the progress of a goal in its current recurrence period.
+type Progress struct {
+	PeriodStart    time.Time // Start of the recurrence period
+	PeriodEnd      time.Time // End of the recurrence period, exclusive
+	TrackedSeconds int       // Seconds tracked towards the goal in the period
+	TargetSeconds  int       // Target of the goal in seconds
+	Ratio          float64   // TrackedSeconds divided by TargetSeconds
+	Achieved       bool      // Whether the tracked time satisfies the comparison with the target
+}
+
+// CurrentPeriod returns the recurrence period of the goal containing now.
+// Daily and monthly periods follow the calendar, weekly periods start on the weekday of StartDate.
+func (g GetGoalsOutput) CurrentPeriod(now time.Time) (time.Time, time.Time) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch g.Recurrence {
+	case RecurrenceWeekly:
+		weekday := time.Monday
+		if start, err := time.ParseInLocation(dateLayout, g.StartDate, now.Location()); err == nil {
+			weekday = start.Weekday()
+		}
+		offset := (int(day.Weekday()) - int(weekday) + 7) % 7
+		start := day.AddDate(0, 0, -offset)
+		return start, start.AddDate(0, 0, 7)
+	case RecurrenceMonthly:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 1, 0)
+	default:
+		return day, day.AddDate(0, 0, 1)
+	}
+}
+
+// Matches reports whether the time entry counts towards the goal according to
+// its user, billable, project, task and tag filters.
+func (g GetGoalsOutput) Matches(te timeentries.GetTimeEntriesOutput) bool {
+	if g.UserId != 0 && te.UserId != 0 && te.UserId != g.UserId {
+		return false
+	}
+	if g.Billable && !te.Billable {
+		return false
+	}
+	if len(g.ProjectIds) > 0 && !slices.Contains(g.ProjectIds, te.ProjectId) {
+		return false
+	}
+	if len(g.TaskIds) > 0 && !slices.Contains(g.TaskIds, te.TaskId) {
+		return false
+	}
+	if len(g.TagIds) > 0 && !slices.ContainsFunc(te.TagIds, func(id int) bool { return slices.Contains(g.TagIds, id) }) {
+		return false
+	}
+	return true
+}
+
+// CurrentProgress computes the progress of the goal in the period containing now from the given time entries.
+// Time entries are clipped to the period, and running time entries count until now.
+func (g GetGoalsOutput) CurrentProgress(entries []timeentries.GetTimeEntriesOutput, now time.Time) Progress {
+	periodStart, periodEnd := g.CurrentPeriod(now)
+	p := Progress{
+		PeriodStart:   periodStart,
+		PeriodEnd:     periodEnd,
+		TargetSeconds: g.TargetSeconds,
+	}
+
+	var tracked time.Duration
+	for _, te := range entries {
+		if !g.Matches(te) {
+			continue
+		}
+		start, err := time.Parse(time.RFC3339, te.Start)
+		if err != nil {
+			continue
+		}
+		var stop time.Time
+		switch {
+		case te.Duration < 0:
+			stop = now
+		case te.Stop != "":
+			if stop, err = time.Parse(time.RFC3339, te.Stop); err != nil {
+				continue
+			}
+		default:
+			stop = start.Add(time.Duration(te.Duration) * time.Second)
+		}
+		if start.Before(periodStart) {
+			start = periodStart
+		}
+		if stop.After(periodEnd) {
+			stop = periodEnd
+		}
+		if stop.After(start) {
+			tracked += stop.Sub(start)
+		}
+	}
+
+	p.TrackedSeconds = int(tracked / time.Second)
+	if p.TargetSeconds > 0 {
+		p.Ratio = float64(p.TrackedSeconds) / float64(p.TargetSeconds)
+	}
+	if g.Comparison == ComparisonLessOrEqual {
+		p.Achieved = p.TrackedSeconds <= p.TargetSeconds
+	} else {
+		p.Achieved = p.TrackedSeconds >= p.TargetSeconds
+	}
+	return p
+}
@@ -0,0 +1,75 @@
+package goals_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/goals"
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+func TestCurrentPeriod(t *testing.T) {
+	// Wednesday
+	now := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	test := []struct {
+		name      string
+		goal      goals.GetGoalsOutput
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"daily", goals.GetGoalsOutput{Recurrence: goals.RecurrenceDaily}, time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)},
+		{"weekly default", goals.GetGoalsOutput{Recurrence: goals.RecurrenceWeekly}, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)},
+		{"weekly from start date", goals.GetGoalsOutput{Recurrence: goals.RecurrenceWeekly, StartDate: "2024-01-04"}, time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)},
+		{"monthly", goals.GetGoalsOutput{Recurrence: goals.RecurrenceMonthly}, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.goal.CurrentPeriod(now)
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Expected %v - %v, got %v - %v", tt.wantStart, tt.wantEnd, start, end)
+			}
+		})
+	}
+}
+
+func TestCurrentProgress(t *testing.T) {
+	now := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	entries := []timeentries.GetTimeEntriesOutput{
+		// 1 hour, project 1, billable
+		{Start: "2024-05-14T09:00:00Z", Stop: "2024-05-14T10:00:00Z", Duration: 3600, ProjectId: 1, Billable: true, TagIds: []int{10}},
+		// 2 hours, project 2
+		{Start: "2024-05-14T11:00:00Z", Stop: "2024-05-14T13:00:00Z", Duration: 7200, ProjectId: 2},
+		// starts before the week, 1 hour counted
+		{Start: "2024-05-12T23:00:00Z", Stop: "2024-05-13T01:00:00Z", Duration: 7200, ProjectId: 1, Billable: true},
+		// running since 9:30, 30 minutes counted
+		{Start: "2024-05-15T09:30:00Z", Duration: -1, ProjectId: 1, TagIds: []int{10, 11}},
+		// previous week
+		{Start: "2024-05-06T09:00:00Z", Stop: "2024-05-06T10:00:00Z", Duration: 3600, ProjectId: 1},
+	}
+	test := []struct {
+		name         string
+		goal         goals.GetGoalsOutput
+		wantTracked  int
+		wantAchieved bool
+	}{
+		{"all", goals.GetGoalsOutput{Recurrence: goals.RecurrenceWeekly, Comparison: goals.ComparisonGreaterOrEqual, TargetSeconds: 3600}, 16200, true},
+		{"project", goals.GetGoalsOutput{Recurrence: goals.RecurrenceWeekly, Comparison: goals.ComparisonGreaterOrEqual, TargetSeconds: 36000, ProjectIds: []int{1}}, 9000, false},
+		{"billable", goals.GetGoalsOutput{Recurrence: goals.RecurrenceWeekly, Comparison: goals.ComparisonGreaterOrEqual, TargetSeconds: 7200, Billable: true}, 7200, true},
+		{"tag", goals.GetGoalsOutput{Recurrence: goals.RecurrenceWeekly, Comparison: goals.ComparisonGreaterOrEqual, TargetSeconds: 3600, TagIds: []int{11}}, 1800, false},
+		{"less or equal", goals.GetGoalsOutput{Recurrence: goals.RecurrenceDaily, Comparison: goals.ComparisonLessOrEqual, TargetSeconds: 3600}, 1800, true},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.goal.CurrentProgress(entries, now)
+			if got.TrackedSeconds != tt.wantTracked {
+				t.Errorf("Expected %d tracked seconds, got %d", tt.wantTracked, got.TrackedSeconds)
+			}
+			if got.Achieved != tt.wantAchieved {
+				t.Errorf("Expected achieved %v, got %v", tt.wantAchieved, got.Achieved)
+			}
+			if want := float64(tt.wantTracked) / float64(tt.goal.TargetSeconds); got.Ratio != want {
+				t.Errorf("Expected ratio %v, got %v", want, got.Ratio)
+			}
+		})
+	}
+}
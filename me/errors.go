@@ -0,0 +1,24 @@
+package me
+
+import (
+	"errors"
+)
+
+var (
+	ErrorStatusNotOK       = errors.New("error response status code")
+	ErrorRequiredParameter = errors.New("required parameter is missing")
+)
+
+// StatusError is the error of an error response, with its status code.
+// It matches ErrorStatusNotOK with errors.Is.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return ErrorStatusNotOK.Error()
+}
+
+func (e *StatusError) Is(target error) bool {
+	return target == ErrorStatusNotOK
+}
@@ -0,0 +1,567 @@
+package me
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+const (
+	mePath          = "/api/v9/me"
+	preferencesPath = "/api/v9/me/preferences"
+	clientsPath     = "/api/v9/me/clients"
+	projectsPath    = "/api/v9/me/projects"
+	tagsPath        = "/api/v9/me/tags"
+	tasksPath       = "/api/v9/me/tasks"
+	featuresPath    = "/api/v9/me/features"
+)
+
+// GetMeQuery represents the query parameters for fetching the current user.
+type GetMeQuery struct {
+	WithRelatedData bool // Retrieve user related data (clients, projects, tasks, tags, workspaces, time entries, etc.)
+}
+
+// GetMeInput contains the input data for GetMe.
+type GetMeInput struct {
+	Query GetMeQuery
+}
+
+// Workspace represents a workspace the current user belongs to.
+type Workspace struct {
+	Admin                       bool    `json:"admin"`
+	At                          string  `json:"at"`
+	BusinessWs                  bool    `json:"business_ws"`
+	DefaultCurrency             string  `json:"default_currency"`
+	DefaultHourlyRate           float64 `json:"default_hourly_rate"`
+	Id                          int     `json:"id"`
+	LogoUrl                     string  `json:"logo_url"`
+	Name                        string  `json:"name"`
+	OnlyAdminsMayCreateProjects bool    `json:"only_admins_may_create_projects"`
+	OnlyAdminsMayCreateTags     bool    `json:"only_admins_may_create_tags"`
+	OnlyAdminsSeeTeamDashboard  bool    `json:"only_admins_see_team_dashboard"`
+	OrganizationId              int     `json:"organization_id"`
+	Premium                     bool    `json:"premium"`
+	ProjectsBillableByDefault   bool    `json:"projects_billable_by_default"`
+	Role                        string  `json:"role"`
+	RoundingMinutes             int     `json:"rounding_minutes"`
+}
+
+// GetMeOutput represents the profile of the current user.
+type GetMeOutput struct {
+	ApiToken               string                             `json:"api_token"`
+	At                     string                             `json:"at"`
+	AuthorizationUpdatedAt string                             `json:"authorization_updated_at"`
+	BeginningOfWeek        int                                `json:"beginning_of_week"` // 0 is Sunday, 1 is Monday, and so on
+	CountryId              int                                `json:"country_id"`
+	CreatedAt              string                             `json:"created_at"`
+	DefaultWorkspaceId     int                                `json:"default_workspace_id"`
+	Email                  string                             `json:"email"`
+	Fullname               string                             `json:"fullname"`
+	HasPassword            bool                               `json:"has_password"`
+	Id                     int                                `json:"id"`
+	ImageUrl               string                             `json:"image_url"`
+	OpenidEmail            string                             `json:"openid_email"`
+	OpenidEnabled          bool                               `json:"openid_enabled"`
+	Timezone               string                             `json:"timezone"`
+	TogglAccountsId        string                             `json:"toggl_accounts_id"`
+	UpdatedAt              string                             `json:"updated_at"`
+	Clients                []GetClientsOutput                 `json:"clients,omitempty"`      // Only with related data
+	Projects               []GetProjectsOutput                `json:"projects,omitempty"`     // Only with related data
+	Tags                   []GetTagsOutput                    `json:"tags,omitempty"`         // Only with related data
+	Tasks                  []GetTasksOutput                   `json:"tasks,omitempty"`        // Only with related data
+	TimeEntries            []timeentries.GetTimeEntriesOutput `json:"time_entries,omitempty"` // Only with related data
+	Workspaces             []Workspace                        `json:"workspaces,omitempty"`   // Only with related data
+}
+
+// GetMe retrieves the profile of the current user.
+func (c Client) GetMe(input GetMeInput) (GetMeOutput, error) {
+	q := url.Values{}
+	q.Add("with_related_data", fmt.Sprintf("%v", input.Query.WithRelatedData))
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = mePath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return GetMeOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return GetMeOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return GetMeOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return GetMeOutput{}, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	gmo := GetMeOutput{}
+	if err := json.Unmarshal(body, &gmo); err != nil {
+		return GetMeOutput{}, err
+	}
+
+	return gmo, nil
+}
+
+// PutMeBody represents the body of the request to update the current user.
+type PutMeBody struct {
+	BeginningOfWeek    *int   `json:"beginning_of_week,omitempty"`    // User's first day of the week. Sunday: 0, Monday: 1, etc.
+	CountryId          int    `json:"country_id,omitempty"`           // User's country ID
+	CurrentPassword    string `json:"current_password,omitempty"`     // User's current password (used to change the current password)
+	DefaultWorkspaceId int    `json:"default_workspace_id,omitempty"` // User's default workspace
+	Email              string `json:"email,omitempty"`                // User's email address
+	Fullname           string `json:"fullname,omitempty"`             // User's full name
+	Password           string `json:"password,omitempty"`             // User's new password (current one must also be provided)
+	Timezone           string `json:"timezone,omitempty"`             // User's timezone
+}
+
+// PutMeInput contains the input data for PutMe.
+type PutMeInput struct {
+	Body PutMeBody
+}
+
+// PutMeOutput represents the response after updating the current user.
+type PutMeOutput = GetMeOutput
+
+// PutMe updates the profile of the current user.
+func (c Client) PutMe(input PutMeInput) (PutMeOutput, error) {
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutMeOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = mePath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutMeOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutMeOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutMeOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutMeOutput{}, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	pmo := PutMeOutput{}
+	if err := json.Unmarshal(body, &pmo); err != nil {
+		return PutMeOutput{}, err
+	}
+
+	return pmo, nil
+}
+
+// AlphaFeature represents an alpha feature toggle of the current user.
+type AlphaFeature struct {
+	Code    string `json:"code"`
+	Enabled bool   `json:"enabled"`
+}
+
+// GetPreferencesOutput represents the preferences of the current user.
+type GetPreferencesOutput struct {
+	AlphaFeatures          []AlphaFeature `json:"alpha_features"`
+	DateFormat             string         `json:"date_format"`
+	DurationFormat         string         `json:"duration_format"`
+	PgTimeZoneName         string         `json:"pg_time_zone_name"`
+	RecordTimeline         bool           `json:"record_timeline"`
+	SendProductEmails      bool           `json:"send_product_emails"`
+	SendTimerNotifications bool           `json:"send_timer_notifications"`
+	SendWeeklyReport       bool           `json:"send_weekly_report"`
+	TimeofdayFormat        string         `json:"timeofday_format"`
+}
+
+// GetPreferences retrieves the preferences of the current user.
+func (c Client) GetPreferences() (GetPreferencesOutput, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = preferencesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return GetPreferencesOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return GetPreferencesOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return GetPreferencesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return GetPreferencesOutput{}, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	gpo := GetPreferencesOutput{}
+	if err := json.Unmarshal(body, &gpo); err != nil {
+		return GetPreferencesOutput{}, err
+	}
+
+	return gpo, nil
+}
+
+// PostPreferencesBody represents the body of the request to update the preferences.
+type PostPreferencesBody struct {
+	AlphaFeatures          []AlphaFeature `json:"alpha_features,omitempty"`
+	DateFormat             string         `json:"date_format,omitempty"`
+	DurationFormat         string         `json:"duration_format,omitempty"`
+	PgTimeZoneName         string         `json:"pg_time_zone_name,omitempty"`
+	RecordTimeline         *bool          `json:"record_timeline,omitempty"`
+	SendProductEmails      *bool          `json:"send_product_emails,omitempty"`
+	SendTimerNotifications *bool          `json:"send_timer_notifications,omitempty"`
+	SendWeeklyReport       *bool          `json:"send_weekly_report,omitempty"`
+	TimeofdayFormat        string         `json:"timeofday_format,omitempty"`
+}
+
+// PostPreferencesInput contains the input data for PostPreferences.
+type PostPreferencesInput struct {
+	Body PostPreferencesBody
+}
+
+// PostPreferences updates the preferences of the current user.
+func (c Client) PostPreferences(input PostPreferencesInput) error {
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = preferencesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	return nil
+}
+
+// GetClientsQuery represents the query parameters for fetching the clients.
+type GetClientsQuery struct {
+	Since *int64 // Retrieve clients modified since this date using UNIX timestamp, including deleted ones.
+}
+
+// GetClientsInput contains the input data for GetClients.
+type GetClientsInput struct {
+	Query GetClientsQuery
+}
+
+// GetClientsOutput represents a client visible to the current user.
+type GetClientsOutput struct {
+	Archived        bool    `json:"archived"`
+	At              string  `json:"at"`
+	CreatorId       int     `json:"creator_id"`
+	Id              int     `json:"id"`
+	Name            string  `json:"name"`
+	ServerDeletedAt *string `json:"server_deleted_at"`
+	Wid             int     `json:"wid"`
+}
+
+// GetClients retrieves the clients of the current user.
+func (c Client) GetClients(input GetClientsInput) ([]GetClientsOutput, error) {
+	q := url.Values{}
+	if input.Query.Since != nil {
+		q.Add("since", fmt.Sprintf("%v", *input.Query.Since))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = clientsPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetClientsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	gco := make([]GetClientsOutput, 0)
+	if err := json.Unmarshal(body, &gco); err != nil {
+		return nil, err
+	}
+
+	return gco, nil
+}
+
+// GetProjectsQuery represents the query parameters for fetching the projects.
+type GetProjectsQuery struct {
+	IncludeArchived bool   // Include archived projects
+	Since           *int64 // Retrieve projects modified since this date using UNIX timestamp, including deleted ones.
+}
+
+// GetProjectsInput contains the input data for GetProjects.
+type GetProjectsInput struct {
+	Query GetProjectsQuery
+}
+
+// GetProjectsOutput represents a project visible to the current user.
+type GetProjectsOutput struct {
+	Active          bool     `json:"active"`
+	ActualHours     *int     `json:"actual_hours"`
+	At              string   `json:"at"`
+	AutoEstimates   *bool    `json:"auto_estimates"`
+	Billable        *bool    `json:"billable"`
+	ClientId        *int     `json:"client_id"`
+	Color           string   `json:"color"`
+	CreatedAt       string   `json:"created_at"`
+	Currency        *string  `json:"currency"`
+	EstimatedHours  *int     `json:"estimated_hours"`
+	FixedFee        *float64 `json:"fixed_fee"`
+	Id              int      `json:"id"`
+	IsPrivate       bool     `json:"is_private"`
+	Name            string   `json:"name"`
+	Rate            *float64 `json:"rate"`
+	Recurring       bool     `json:"recurring"`
+	ServerDeletedAt *string  `json:"server_deleted_at"`
+	Template        *bool    `json:"template"`
+	WorkspaceId     int      `json:"workspace_id"`
+}
+
+// GetProjects retrieves the projects of the current user.
+func (c Client) GetProjects(input GetProjectsInput) ([]GetProjectsOutput, error) {
+	q := url.Values{}
+	q.Add("include_archived", fmt.Sprintf("%v", input.Query.IncludeArchived))
+	if input.Query.Since != nil {
+		q.Add("since", fmt.Sprintf("%v", *input.Query.Since))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = projectsPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetProjectsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	gpo := make([]GetProjectsOutput, 0)
+	if err := json.Unmarshal(body, &gpo); err != nil {
+		return nil, err
+	}
+
+	return gpo, nil
+}
+
+// GetTagsQuery represents the query parameters for fetching the tags.
+type GetTagsQuery struct {
+	Since *int64 // Retrieve tags modified since this date using UNIX timestamp, including deleted ones.
+}
+
+// GetTagsInput contains the input data for GetTags.
+type GetTagsInput struct {
+	Query GetTagsQuery
+}
+
+// GetTagsOutput represents a tag visible to the current user.
+type GetTagsOutput struct {
+	At          string  `json:"at"`
+	CreatorId   int     `json:"creator_id"`
+	DeletedAt   *string `json:"deleted_at"`
+	Id          int     `json:"id"`
+	Name        string  `json:"name"`
+	WorkspaceId int     `json:"workspace_id"`
+}
+
+// GetTags retrieves the tags of the current user.
+func (c Client) GetTags(input GetTagsInput) ([]GetTagsOutput, error) {
+	q := url.Values{}
+	if input.Query.Since != nil {
+		q.Add("since", fmt.Sprintf("%v", *input.Query.Since))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = tagsPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetTagsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	gto := make([]GetTagsOutput, 0)
+	if err := json.Unmarshal(body, &gto); err != nil {
+		return nil, err
+	}
+
+	return gto, nil
+}
+
+// GetTasksQuery represents the query parameters for fetching the tasks.
+type GetTasksQuery struct {
+	Since            *int64 // Retrieve tasks modified since this date using UNIX timestamp.
+	IncludeNotActive bool   // Include tasks marked as done
+	Offset           *int   // Offset to resume the next pagination from
+	PerPage          *int   // Number of items per page
+}
+
+// GetTasksInput contains the input data for GetTasks.
+type GetTasksInput struct {
+	Query GetTasksQuery
+}
+
+// GetTasksOutput represents a task visible to the current user.
+type GetTasksOutput struct {
+	Active           bool    `json:"active"`
+	At               string  `json:"at"`
+	EstimatedSeconds *int    `json:"estimated_seconds"`
+	Id               int     `json:"id"`
+	Name             string  `json:"name"`
+	ProjectId        int     `json:"project_id"`
+	Recurring        bool    `json:"recurring"`
+	ServerDeletedAt  *string `json:"server_deleted_at"`
+	TrackedSeconds   int     `json:"tracked_seconds"`
+	UserId           *int    `json:"user_id"`
+	WorkspaceId      int     `json:"workspace_id"`
+}
+
+// GetTasks retrieves the tasks of the current user.
+func (c Client) GetTasks(input GetTasksInput) ([]GetTasksOutput, error) {
+	q := url.Values{}
+	q.Add("include_not_active", fmt.Sprintf("%v", input.Query.IncludeNotActive))
+	if input.Query.Since != nil {
+		q.Add("since", fmt.Sprintf("%v", *input.Query.Since))
+	}
+	if input.Query.Offset != nil {
+		q.Add("offset", fmt.Sprintf("%v", *input.Query.Offset))
+	}
+	if input.Query.PerPage != nil {
+		q.Add("per_page", fmt.Sprintf("%v", *input.Query.PerPage))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = tasksPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetTasksOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	gto := make([]GetTasksOutput, 0)
+	if err := json.Unmarshal(body, &gto); err != nil {
+		return nil, err
+	}
+
+	return gto, nil
+}
+
+// Feature represents a single feature flag of a workspace.
+type Feature struct {
+	Enabled   bool   `json:"enabled"`
+	FeatureId int    `json:"feature_id"`
+	Name      string `json:"name"`
+}
+
+// GetFeaturesOutput represents the features available in a workspace of the current user.
+type GetFeaturesOutput struct {
+	Features    []Feature `json:"features"`
+	WorkspaceId int       `json:"workspace_id"`
+}
+
+// GetFeatures retrieves the features available in the workspaces of the current user.
+func (c Client) GetFeatures() ([]GetFeaturesOutput, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = featuresPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetFeaturesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	gfo := make([]GetFeaturesOutput, 0)
+	if err := json.Unmarshal(body, &gfo); err != nil {
+		return nil, err
+	}
+
+	return gfo, nil
+}
@@ -0,0 +1,322 @@
+package me_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) me.Client {
+	return me.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetMe(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/me.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(me.GetMeOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   me.Client
+		arg      me.GetMeInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, me.GetMeInput{Query: me.GetMeQuery{WithRelatedData: true}}, testFile, nil},
+		{"http error", errorClient, me.GetMeInput{}, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetMe(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutMe(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/me.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(me.PutMeOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	beginningOfWeek := 1
+	test := []struct {
+		name     string
+		client   me.Client
+		arg      me.PutMeInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, me.PutMeInput{Body: me.PutMeBody{BeginningOfWeek: &beginningOfWeek}}, testFile, nil},
+		{"http error", errorClient, me.PutMeInput{}, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutMe(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestGetPreferences(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/preferences.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(me.GetPreferencesOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   me.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetPreferences()
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostPreferences(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	test := []struct {
+		name    string
+		client  me.Client
+		arg     me.PostPreferencesInput
+		wantErr error
+	}{
+		{"success", successClient, me.PostPreferencesInput{Body: me.PostPreferencesBody{DateFormat: "YYYY-MM-DD"}}, nil},
+		{"http error", errorClient, me.PostPreferencesInput{}, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.PostPreferences(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetClients(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/clients.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   me.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetClients(me.GetClientsInput{})
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestGetProjects(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/projects.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   me.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetProjects(me.GetProjectsInput{Query: me.GetProjectsQuery{IncludeArchived: true}})
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestGetTags(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/tags.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   me.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetTags(me.GetTagsInput{})
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestGetTasks(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/tasks.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	perPage := 50
+	test := []struct {
+		name     string
+		client   me.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetTasks(me.GetTasksInput{Query: me.GetTasksQuery{PerPage: &perPage}})
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestGetFeatures(t *testing.T) {
+	testFile := readTestFile(t, "testdata/me/features.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   me.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, me.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetFeatures()
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package organizations
+
+import (
+	"errors"
+)
+
+var (
+	ErrorStatusNotOK       = errors.New("error response status code")
+	ErrorRequiredParameter = errors.New("required parameter is missing")
+)
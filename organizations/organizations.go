@@ -0,0 +1,691 @@
+package organizations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	organizationPath = "/api/v9/organizations/%d"
+	usersPath        = "/api/v9/organizations/%d/users"
+	userPath         = "/api/v9/organizations/%d/users/%d"
+	invitationPath   = "/api/v9/organizations/%d/invitation"
+	groupsPath       = "/api/v9/organizations/%d/groups"
+	groupPath        = "/api/v9/organizations/%d/groups/%d"
+	workspacesPath   = "/api/v9/organizations/%d/workspaces"
+)
+
+// GetOrganizationInput contains the input data for GetOrganization.
+type GetOrganizationInput struct {
+	OrganizationId int // required
+}
+
+// GetOrganizationOutput represents an organization fetched from Toggl.
+type GetOrganizationOutput struct {
+	Admin                   bool     `json:"admin"`
+	At                      string   `json:"at"`
+	CreatedAt               string   `json:"created_at"`
+	Id                      int      `json:"id"`
+	IsMultiWorkspaceEnabled bool     `json:"is_multi_workspace_enabled"`
+	IsUnified               bool     `json:"is_unified"`
+	MaxDataRetentionDays    int      `json:"max_data_retention_days"`
+	MaxWorkspaces           int      `json:"max_workspaces"`
+	Name                    string   `json:"name"`
+	Owner                   bool     `json:"owner"`
+	Permissions             []string `json:"permissions"`
+	PricingPlanId           int      `json:"pricing_plan_id"`
+	PricingPlanName         string   `json:"pricing_plan_name"`
+	ServerDeletedAt         *string  `json:"server_deleted_at"`
+	SuspendedAt             *string  `json:"suspended_at"`
+	UserCount               int      `json:"user_count"`
+}
+
+// GetOrganization retrieves an organization by its ID.
+func (c Client) GetOrganization(input GetOrganizationInput) (GetOrganizationOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return GetOrganizationOutput{}, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(organizationPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return GetOrganizationOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return GetOrganizationOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return GetOrganizationOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return GetOrganizationOutput{}, ErrorStatusNotOK
+	}
+
+	goo := GetOrganizationOutput{}
+	if err := json.Unmarshal(body, &goo); err != nil {
+		return GetOrganizationOutput{}, err
+	}
+
+	return goo, nil
+}
+
+// PutOrganizationBody represents the body of the request to update an organization.
+type PutOrganizationBody struct {
+	Name string `json:"name"` // Organization name
+}
+
+// PutOrganizationInput contains the input data for PutOrganization.
+type PutOrganizationInput struct {
+	OrganizationId int // required
+	Body           PutOrganizationBody
+}
+
+// PutOrganization updates an existing organization.
+func (c Client) PutOrganization(input PutOrganizationInput) error {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(organizationPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// GetUsersQuery represents the query parameters for fetching organization users.
+type GetUsersQuery struct {
+	Filter       *string // Returns records where name or email contains this string
+	ActiveStatus *string // Possible values: active, inactive, invited
+	OnlyAdmins   bool    // Returns only admins
+	Groups       *string // Numeric group IDs separated by comma
+	Workspaces   *string // Numeric workspace IDs separated by comma
+	Page         *int    // Page number
+	PerPage      *int    // Number of items per page
+	SortDir      *string // Possible values: asc, desc
+}
+
+// GetUsersInput contains the input data for GetUsers.
+type GetUsersInput struct {
+	OrganizationId int // required
+	Query          GetUsersQuery
+}
+
+// UserGroup represents a group membership of an organization user.
+type UserGroup struct {
+	GroupId int    `json:"group_id"`
+	Name    string `json:"name"`
+}
+
+// UserWorkspace represents a workspace membership of an organization user.
+type UserWorkspace struct {
+	Admin         bool   `json:"admin"`
+	Role          string `json:"role"`
+	WorkspaceId   int    `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+}
+
+// GetUsersOutput represents a user of an organization.
+type GetUsersOutput struct {
+	Admin        bool            `json:"admin"`
+	AvatarUrl    string          `json:"avatar_url"`
+	CanEditEmail bool            `json:"can_edit_email"`
+	Email        string          `json:"email"`
+	Groups       []UserGroup     `json:"groups"`
+	Id           int             `json:"id"`
+	Inactive     bool            `json:"inactive"`
+	Joined       bool            `json:"joined"`
+	Name         string          `json:"name"`
+	Owner        bool            `json:"owner"`
+	UserId       int             `json:"user_id"`
+	Workspaces   []UserWorkspace `json:"workspaces"`
+}
+
+// GetUsers retrieves the users of an organization.
+func (c Client) GetUsers(input GetUsersInput) ([]GetUsersOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return nil, ErrorRequiredParameter
+	}
+	guq := input.Query
+	q := url.Values{}
+	q.Add("only_admins", fmt.Sprintf("%v", guq.OnlyAdmins))
+	if guq.Filter != nil {
+		q.Add("filter", *guq.Filter)
+	}
+	if guq.ActiveStatus != nil {
+		q.Add("active_status", *guq.ActiveStatus)
+	}
+	if guq.Groups != nil {
+		q.Add("groups", *guq.Groups)
+	}
+	if guq.Workspaces != nil {
+		q.Add("workspaces", *guq.Workspaces)
+	}
+	if guq.Page != nil {
+		q.Add("page", fmt.Sprintf("%v", *guq.Page))
+	}
+	if guq.PerPage != nil {
+		q.Add("per_page", fmt.Sprintf("%v", *guq.PerPage))
+	}
+	if guq.SortDir != nil {
+		q.Add("sort_dir", *guq.SortDir)
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(usersPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetUsersOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	guo := make([]GetUsersOutput, 0)
+	if err := json.Unmarshal(body, &guo); err != nil {
+		return nil, err
+	}
+
+	return guo, nil
+}
+
+// InvitationWorkspace represents a workspace an invited user will join.
+type InvitationWorkspace struct {
+	Admin       bool   `json:"admin,omitempty"`
+	Role        string `json:"role,omitempty"`
+	WorkspaceId int    `json:"workspace_id"`
+}
+
+// PostInvitationBody represents the body of the request to invite users to an organization.
+type PostInvitationBody struct {
+	Emails     []string              `json:"emails"`                // Emails of the invited users
+	SendEmails *bool                 `json:"send_emails,omitempty"` // Whether invitation emails should be sent, default true
+	Workspaces []InvitationWorkspace `json:"workspaces"`            // Workspaces the users will join
+}
+
+// PostInvitationInput contains the input data for PostInvitation.
+type PostInvitationInput struct {
+	OrganizationId int // required
+	Body           PostInvitationBody
+}
+
+// Invitation represents a single invitation created for an organization.
+type Invitation struct {
+	Email          string `json:"email"`
+	InvitationId   int    `json:"invitation_id"`
+	InviteUrl      string `json:"invite_url"`
+	OrganizationId int    `json:"organization_id"`
+	RecipientId    int    `json:"recipient_id"`
+	SenderId       int    `json:"sender_id"`
+}
+
+// PostInvitationOutput represents the response after inviting users to an organization.
+type PostInvitationOutput struct {
+	Data     []Invitation `json:"data"`
+	Messages []string     `json:"messages"`
+}
+
+// PostInvitation invites users to an organization.
+func (c Client) PostInvitation(input PostInvitationInput) (PostInvitationOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return PostInvitationOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostInvitationOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(invitationPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostInvitationOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostInvitationOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostInvitationOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostInvitationOutput{}, ErrorStatusNotOK
+	}
+
+	pio := PostInvitationOutput{}
+	if err := json.Unmarshal(body, &pio); err != nil {
+		return PostInvitationOutput{}, err
+	}
+
+	return pio, nil
+}
+
+// PutUserBody represents the body of the request to update an organization user.
+type PutUserBody struct {
+	Email             string                `json:"email,omitempty"`              // New email of the user
+	Inactive          *bool                 `json:"inactive,omitempty"`           // Whether the user is deactivated
+	OrganizationAdmin *bool                 `json:"organization_admin,omitempty"` // Whether the user is an organization admin
+	Workspaces        []InvitationWorkspace `json:"workspaces,omitempty"`         // Workspace roles of the user
+}
+
+// PutUserInput contains the input data for PutUser.
+type PutUserInput struct {
+	OrganizationId     int // required
+	OrganizationUserId int // required
+	Body               PutUserBody
+}
+
+// PutUser updates the roles of an organization user.
+func (c Client) PutUser(input PutUserInput) error {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return ErrorRequiredParameter
+	}
+	if input.OrganizationUserId == 0 {
+		slog.Error("OrganizationUserId is required")
+		return ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(userPath, input.OrganizationId, input.OrganizationUserId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// DeleteUsersInput contains the input data for DeleteUsers.
+type DeleteUsersInput struct {
+	OrganizationId      int   // required
+	OrganizationUserIds []int // required
+}
+
+// DeleteUsers removes users from an organization.
+func (c Client) DeleteUsers(input DeleteUsersInput) error {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return ErrorRequiredParameter
+	}
+	if len(input.OrganizationUserIds) == 0 {
+		slog.Error("OrganizationUserIds is required")
+		return ErrorRequiredParameter
+	}
+	j, err := json.Marshal(struct {
+		Delete []int `json:"delete"`
+	}{input.OrganizationUserIds})
+	if err != nil {
+		return err
+	}
+	toggl := c.Patch(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(usersPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// GetGroupsQuery represents the query parameters for fetching organization groups.
+type GetGroupsQuery struct {
+	Name      *string // Returns groups whose name contains this string
+	Workspace *int    // Returns groups assigned to this workspace
+}
+
+// GetGroupsInput contains the input data for GetGroups.
+type GetGroupsInput struct {
+	OrganizationId int // required
+	Query          GetGroupsQuery
+}
+
+// GroupUser represents a member of an organization group.
+type GroupUser struct {
+	AvatarUrl string `json:"avatar_url"`
+	Inactive  bool   `json:"inactive"`
+	Joined    bool   `json:"joined"`
+	Name      string `json:"name"`
+	UserId    int    `json:"user_id"`
+}
+
+// GetGroupsOutput represents a group of an organization.
+type GetGroupsOutput struct {
+	At          string      `json:"at"`
+	GroupId     int         `json:"group_id"`
+	Name        string      `json:"name"`
+	Permissions []string    `json:"permissions"`
+	Users       []GroupUser `json:"users"`
+	Workspaces  []int       `json:"workspaces"`
+}
+
+// GetGroups retrieves the groups of an organization.
+func (c Client) GetGroups(input GetGroupsInput) ([]GetGroupsOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return nil, ErrorRequiredParameter
+	}
+	q := url.Values{}
+	if input.Query.Name != nil {
+		q.Add("name", *input.Query.Name)
+	}
+	if input.Query.Workspace != nil {
+		q.Add("workspace", fmt.Sprintf("%v", *input.Query.Workspace))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(groupsPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetGroupsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	ggo := make([]GetGroupsOutput, 0)
+	if err := json.Unmarshal(body, &ggo); err != nil {
+		return nil, err
+	}
+
+	return ggo, nil
+}
+
+// PostGroupBody represents the body of the request to create an organization group.
+type PostGroupBody struct {
+	Name       string `json:"name"`                 // Group name, required
+	Users      []int  `json:"users,omitempty"`      // IDs of the users in the group
+	Workspaces []int  `json:"workspaces,omitempty"` // IDs of the workspaces assigned to the group
+}
+
+// PostGroupInput contains the input data for PostGroup.
+type PostGroupInput struct {
+	OrganizationId int // required
+	Body           PostGroupBody
+}
+
+// PostGroupOutput represents the response after creating an organization group.
+type PostGroupOutput = GetGroupsOutput
+
+// PostGroup creates a new group in an organization.
+func (c Client) PostGroup(input PostGroupInput) (PostGroupOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return PostGroupOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostGroupOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(groupsPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostGroupOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostGroupOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostGroupOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostGroupOutput{}, ErrorStatusNotOK
+	}
+
+	pgo := PostGroupOutput{}
+	if err := json.Unmarshal(body, &pgo); err != nil {
+		return PostGroupOutput{}, err
+	}
+
+	return pgo, nil
+}
+
+// PutGroupBody represents the body of the request to update an organization group.
+type PutGroupBody = PostGroupBody
+
+// PutGroupInput contains the input data for PutGroup.
+type PutGroupInput struct {
+	OrganizationId int // required
+	GroupId        int // required
+	Body           PutGroupBody
+}
+
+// PutGroupOutput represents the response after updating an organization group.
+type PutGroupOutput = GetGroupsOutput
+
+// PutGroup updates an existing organization group.
+func (c Client) PutGroup(input PutGroupInput) (PutGroupOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return PutGroupOutput{}, ErrorRequiredParameter
+	}
+	if input.GroupId == 0 {
+		slog.Error("GroupId is required")
+		return PutGroupOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutGroupOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(groupPath, input.OrganizationId, input.GroupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutGroupOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutGroupOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutGroupOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutGroupOutput{}, ErrorStatusNotOK
+	}
+
+	pgo := PutGroupOutput{}
+	if err := json.Unmarshal(body, &pgo); err != nil {
+		return PutGroupOutput{}, err
+	}
+
+	return pgo, nil
+}
+
+// DeleteGroupInput contains the input data for DeleteGroup.
+type DeleteGroupInput struct {
+	OrganizationId int // required
+	GroupId        int // required
+}
+
+// DeleteGroup deletes a group from an organization.
+func (c Client) DeleteGroup(input DeleteGroupInput) error {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return ErrorRequiredParameter
+	}
+	if input.GroupId == 0 {
+		slog.Error("GroupId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(groupPath, input.OrganizationId, input.GroupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// GetWorkspacesInput contains the input data for GetWorkspaces.
+type GetWorkspacesInput struct {
+	OrganizationId int // required
+}
+
+// GetWorkspacesOutput represents a workspace of an organization.
+type GetWorkspacesOutput struct {
+	Admin             bool    `json:"admin"`
+	At                string  `json:"at"`
+	BusinessWs        bool    `json:"business_ws"`
+	DefaultCurrency   string  `json:"default_currency"`
+	DefaultHourlyRate float64 `json:"default_hourly_rate"`
+	Id                int     `json:"id"`
+	Name              string  `json:"name"`
+	OrganizationId    int     `json:"organization_id"`
+	Premium           bool    `json:"premium"`
+	Role              string  `json:"role"`
+}
+
+// GetWorkspaces retrieves the workspaces of an organization.
+func (c Client) GetWorkspaces(input GetWorkspacesInput) ([]GetWorkspacesOutput, error) {
+	if input.OrganizationId == 0 {
+		slog.Error("OrganizationId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(workspacesPath, input.OrganizationId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetWorkspacesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gwo := make([]GetWorkspacesOutput, 0)
+	if err := json.Unmarshal(body, &gwo); err != nil {
+		return nil, err
+	}
+
+	return gwo, nil
+}
@@ -0,0 +1,399 @@
+package organizations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/organizations"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) organizations.Client {
+	return organizations.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetOrganization(t *testing.T) {
+	testFile := readTestFile(t, "testdata/organizations/organization.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(organizations.GetOrganizationOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	test := []struct {
+		name     string
+		client   organizations.Client
+		arg      organizations.GetOrganizationInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, organizations.GetOrganizationInput{OrganizationId: organizationId}, testFile, nil},
+		{"parameter error", successClient, organizations.GetOrganizationInput{}, errorWant, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.GetOrganizationInput{OrganizationId: organizationId}, errorWant, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetOrganization(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutOrganization(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	organizationId := 123456789
+	body := organizations.PutOrganizationBody{Name: "name"}
+	test := []struct {
+		name    string
+		client  organizations.Client
+		arg     organizations.PutOrganizationInput
+		wantErr error
+	}{
+		{"success", successClient, organizations.PutOrganizationInput{OrganizationId: organizationId, Body: body}, nil},
+		{"parameter error", successClient, organizations.PutOrganizationInput{Body: body}, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.PutOrganizationInput{OrganizationId: organizationId, Body: body}, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.PutOrganization(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetUsers(t *testing.T) {
+	testFile := readTestFile(t, "testdata/organizations/users.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	activeStatus := "active"
+	test := []struct {
+		name     string
+		client   organizations.Client
+		arg      organizations.GetUsersInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, organizations.GetUsersInput{OrganizationId: organizationId, Query: organizations.GetUsersQuery{ActiveStatus: &activeStatus}}, testFile, nil},
+		{"parameter error", successClient, organizations.GetUsersInput{}, errorWant, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.GetUsersInput{OrganizationId: organizationId}, errorWant, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetUsers(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostInvitation(t *testing.T) {
+	testFile := readTestFile(t, "testdata/organizations/invitation.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(organizations.PostInvitationOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	body := organizations.PostInvitationBody{
+		Emails:     []string{"user@example.com"},
+		Workspaces: []organizations.InvitationWorkspace{{WorkspaceId: 1}},
+	}
+	test := []struct {
+		name     string
+		client   organizations.Client
+		arg      organizations.PostInvitationInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, organizations.PostInvitationInput{OrganizationId: organizationId, Body: body}, testFile, nil},
+		{"parameter error", successClient, organizations.PostInvitationInput{Body: body}, errorWant, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.PostInvitationInput{OrganizationId: organizationId, Body: body}, errorWant, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostInvitation(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutUser(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	organizationId := 123456789
+	organizationUserId := 1234567890
+	admin := true
+	body := organizations.PutUserBody{OrganizationAdmin: &admin}
+	test := []struct {
+		name    string
+		client  organizations.Client
+		arg     organizations.PutUserInput
+		wantErr error
+	}{
+		{"success", successClient, organizations.PutUserInput{OrganizationId: organizationId, OrganizationUserId: organizationUserId, Body: body}, nil},
+		{"OrganizationId parameter error", successClient, organizations.PutUserInput{OrganizationUserId: organizationUserId}, organizations.ErrorRequiredParameter},
+		{"OrganizationUserId parameter error", successClient, organizations.PutUserInput{OrganizationId: organizationId}, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.PutUserInput{OrganizationId: organizationId, OrganizationUserId: organizationUserId}, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.PutUser(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestDeleteUsers(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	organizationId := 123456789
+	organizationUserIds := []int{1234567890, 1234567891}
+	test := []struct {
+		name    string
+		client  organizations.Client
+		arg     organizations.DeleteUsersInput
+		wantErr error
+	}{
+		{"success", successClient, organizations.DeleteUsersInput{OrganizationId: organizationId, OrganizationUserIds: organizationUserIds}, nil},
+		{"OrganizationId parameter error", successClient, organizations.DeleteUsersInput{OrganizationUserIds: organizationUserIds}, organizations.ErrorRequiredParameter},
+		{"OrganizationUserIds parameter error", successClient, organizations.DeleteUsersInput{OrganizationId: organizationId}, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.DeleteUsersInput{OrganizationId: organizationId, OrganizationUserIds: organizationUserIds}, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteUsers(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetGroups(t *testing.T) {
+	testFile := readTestFile(t, "testdata/organizations/groups.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	test := []struct {
+		name     string
+		client   organizations.Client
+		arg      organizations.GetGroupsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, organizations.GetGroupsInput{OrganizationId: organizationId}, testFile, nil},
+		{"parameter error", successClient, organizations.GetGroupsInput{}, errorWant, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.GetGroupsInput{OrganizationId: organizationId}, errorWant, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetGroups(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostGroup(t *testing.T) {
+	testFile := readTestFile(t, "testdata/organizations/group.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(organizations.PostGroupOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	body := organizations.PostGroupBody{Name: "name", Users: []int{1}}
+	test := []struct {
+		name     string
+		client   organizations.Client
+		arg      organizations.PostGroupInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, organizations.PostGroupInput{OrganizationId: organizationId, Body: body}, testFile, nil},
+		{"parameter error", successClient, organizations.PostGroupInput{Body: body}, errorWant, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.PostGroupInput{OrganizationId: organizationId, Body: body}, errorWant, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutGroup(t *testing.T) {
+	testFile := readTestFile(t, "testdata/organizations/group.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(organizations.PutGroupOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	groupId := 1234567890
+	body := organizations.PutGroupBody{Name: "name"}
+	test := []struct {
+		name     string
+		client   organizations.Client
+		arg      organizations.PutGroupInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, organizations.PutGroupInput{OrganizationId: organizationId, GroupId: groupId, Body: body}, testFile, nil},
+		{"OrganizationId parameter error", successClient, organizations.PutGroupInput{GroupId: groupId}, errorWant, organizations.ErrorRequiredParameter},
+		{"GroupId parameter error", successClient, organizations.PutGroupInput{OrganizationId: organizationId}, errorWant, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.PutGroupInput{OrganizationId: organizationId, GroupId: groupId}, errorWant, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	organizationId := 123456789
+	groupId := 1234567890
+	test := []struct {
+		name    string
+		client  organizations.Client
+		arg     organizations.DeleteGroupInput
+		wantErr error
+	}{
+		{"success", successClient, organizations.DeleteGroupInput{OrganizationId: organizationId, GroupId: groupId}, nil},
+		{"OrganizationId parameter error", successClient, organizations.DeleteGroupInput{GroupId: groupId}, organizations.ErrorRequiredParameter},
+		{"GroupId parameter error", successClient, organizations.DeleteGroupInput{OrganizationId: organizationId}, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.DeleteGroupInput{OrganizationId: organizationId, GroupId: groupId}, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetWorkspaces(t *testing.T) {
+	testFile := readTestFile(t, "testdata/organizations/workspaces.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizationId := 123456789
+	test := []struct {
+		name     string
+		client   organizations.Client
+		arg      organizations.GetWorkspacesInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, organizations.GetWorkspacesInput{OrganizationId: organizationId}, testFile, nil},
+		{"parameter error", successClient, organizations.GetWorkspacesInput{}, errorWant, organizations.ErrorRequiredParameter},
+		{"http error", errorClient, organizations.GetWorkspacesInput{OrganizationId: organizationId}, errorWant, organizations.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetWorkspaces(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package rates
+
+import (
+	"time"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/dev-shimada/toggl-go/workspaces"
+)
+
+// ProjectUser identifies a user in a project.
+type ProjectUser struct {
+	ProjectId int
+	UserId    int
+}
+
+// RateTable holds the hourly rates used to compute the billable amount of time entries.
+// The most specific rate wins: task, project user, project, workspace user and finally the workspace rate.
+//
+// The current rates apply to every time entry. Once the rate history of a level is added
+// with AddRates, the rate in effect at the start of the time entry applies instead.
+type RateTable struct {
+	Workspace      float64                 // Default hourly rate of the workspace
+	WorkspaceUsers map[int]float64         // Hourly rates by user ID
+	Projects       map[int]float64         // Hourly rates by project ID
+	ProjectUsers   map[ProjectUser]float64 // Hourly rates by project and user ID
+	Tasks          map[int]float64         // Hourly rates by task ID
+
+	history          map[levelKey][]GetRatesOutput
+	workspaceUserIds map[int]int         // Workspace user IDs by user ID
+	projectUserIds   map[ProjectUser]int // Project user IDs by project and user ID
+}
+
+// levelKey identifies the entity of a rate history.
+type levelKey struct {
+	Level   Level
+	LevelId int
+}
+
+// NewRateTable creates a RateTable with the default hourly rate of the workspace.
+func NewRateTable(workspace me.Workspace) *RateTable {
+	return &RateTable{
+		Workspace:      workspace.DefaultHourlyRate,
+		WorkspaceUsers: map[int]float64{},
+		Projects:       map[int]float64{},
+		ProjectUsers:   map[ProjectUser]float64{},
+		Tasks:          map[int]float64{},
+	}
+}
+
+// AddProjects adds the rates of the projects which have one.
+func (r *RateTable) AddProjects(projects []me.GetProjectsOutput) {
+	if r.Projects == nil {
+		r.Projects = map[int]float64{}
+	}
+	for _, p := range projects {
+		if p.Rate != nil {
+			r.Projects[p.Id] = *p.Rate
+		}
+	}
+}
+
+// AddWorkspaceUsers adds the rates of the workspace users which have one.
+func (r *RateTable) AddWorkspaceUsers(users []workspaces.GetWorkspaceUsersOutput) {
+	if r.WorkspaceUsers == nil {
+		r.WorkspaceUsers = map[int]float64{}
+	}
+	if r.workspaceUserIds == nil {
+		r.workspaceUserIds = map[int]int{}
+	}
+	for _, u := range users {
+		r.workspaceUserIds[u.Uid] = u.Id
+		if u.Rate != nil {
+			r.WorkspaceUsers[u.Uid] = *u.Rate
+		}
+	}
+}
+
+// AddProjectUsers adds the rates of the project users which have one.
+func (r *RateTable) AddProjectUsers(users []workspaces.GetProjectUsersOutput) {
+	if r.ProjectUsers == nil {
+		r.ProjectUsers = map[ProjectUser]float64{}
+	}
+	if r.projectUserIds == nil {
+		r.projectUserIds = map[ProjectUser]int{}
+	}
+	for _, u := range users {
+		pu := ProjectUser{ProjectId: u.ProjectId, UserId: u.UserId}
+		r.projectUserIds[pu] = u.Id
+		if u.Rate != nil {
+			r.ProjectUsers[pu] = *u.Rate
+		}
+	}
+}
+
+// AddRates adds the rate history of a level returned by GetRates.
+// The current rate of a task or project is also added to Tasks or Projects, since
+// the rates of tasks are only available from the history.
+// The history of workspace users and project users applies once they are added
+// with AddWorkspaceUsers and AddProjectUsers, which map them to their user IDs.
+func (r *RateTable) AddRates(level Level, levelId int, history []GetRatesOutput) {
+	if r.history == nil {
+		r.history = map[levelKey][]GetRatesOutput{}
+	}
+	key := levelKey{Level: level, LevelId: levelId}
+	r.history[key] = append(r.history[key], history...)
+	for _, rate := range history {
+		if rate.End != nil {
+			continue
+		}
+		switch level {
+		case LevelTask:
+			if r.Tasks == nil {
+				r.Tasks = map[int]float64{}
+			}
+			r.Tasks[levelId] = rate.Amount
+		case LevelProject:
+			if r.Projects == nil {
+				r.Projects = map[int]float64{}
+			}
+			r.Projects[levelId] = rate.Amount
+		}
+	}
+}
+
+// rateAt returns the rate of the history in effect at start, false if there was none.
+func rateAt(history []GetRatesOutput, start time.Time) (float64, bool) {
+	for _, rate := range history {
+		from, err := time.Parse(time.RFC3339, rate.Start)
+		if err != nil || start.Before(from) {
+			continue
+		}
+		if rate.End != nil {
+			to, err := time.Parse(time.RFC3339, *rate.End)
+			if err != nil || !start.Before(to) {
+				continue
+			}
+		}
+		return rate.Amount, true
+	}
+	return 0, false
+}
+
+// Rate returns the hourly rate applying to the time entry at its start.
+func (r RateTable) Rate(te timeentries.GetTimeEntriesOutput) float64 {
+	start, err := time.Parse(time.RFC3339, te.Start)
+	// lookup returns the rate of the level in effect at the start of the time entry
+	// if its history was added, otherwise the current rate.
+	lookup := func(level Level, levelId int, current float64, ok bool) (float64, bool) {
+		if history, found := r.history[levelKey{Level: level, LevelId: levelId}]; found && levelId != 0 && err == nil {
+			return rateAt(history, start)
+		}
+		return current, ok
+	}
+	if te.TaskId != 0 {
+		rate, ok := r.Tasks[te.TaskId]
+		if rate, ok := lookup(LevelTask, te.TaskId, rate, ok); ok {
+			return rate
+		}
+	}
+	if te.ProjectId != 0 {
+		pu := ProjectUser{ProjectId: te.ProjectId, UserId: te.UserId}
+		rate, ok := r.ProjectUsers[pu]
+		if rate, ok := lookup(LevelProjectUser, r.projectUserIds[pu], rate, ok); ok {
+			return rate
+		}
+		rate, ok = r.Projects[te.ProjectId]
+		if rate, ok := lookup(LevelProject, te.ProjectId, rate, ok); ok {
+			return rate
+		}
+	}
+	rate, ok := r.WorkspaceUsers[te.UserId]
+	if rate, ok := lookup(LevelWorkspaceUser, r.workspaceUserIds[te.UserId], rate, ok); ok {
+		return rate
+	}
+	if rate, ok := lookup(LevelWorkspace, te.WorkspaceId, r.Workspace, true); ok {
+		return rate
+	}
+	return r.Workspace
+}
+
+// BillableAmount returns the billable amount of the time entries in the workspace currency.
+// Non-billable and running time entries are not counted. Without rate history, the
+// current rates apply, even to time entries started before a rate changed.
+func (r RateTable) BillableAmount(entries []timeentries.GetTimeEntriesOutput) float64 {
+	amount := 0.0
+	for _, te := range entries {
+		if !te.Billable || te.Duration < 0 {
+			continue
+		}
+		amount += r.Rate(te) * float64(te.Duration) / 3600
+	}
+	return amount
+}
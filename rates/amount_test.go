@@ -0,0 +1,86 @@
+package rates_test
+
+import (
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/rates"
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/dev-shimada/toggl-go/workspaces"
+)
+
+func TestRate(t *testing.T) {
+	projectRate := 30.0
+	userRate := 20.0
+	table := rates.NewRateTable(me.Workspace{DefaultHourlyRate: 10})
+	table.AddWorkspaceUsers([]workspaces.GetWorkspaceUsersOutput{{Uid: 1, Rate: &userRate}, {Uid: 2}})
+	table.AddProjects([]me.GetProjectsOutput{{Id: 100, Rate: &projectRate}, {Id: 200}})
+	projectUserRate := 40.0
+	table.AddProjectUsers([]workspaces.GetProjectUsersOutput{{Id: 7, ProjectId: 100, UserId: 2, Rate: &projectUserRate}, {Id: 8, ProjectId: 100, UserId: 1}})
+	table.AddRates(rates.LevelTask, 1000, []rates.GetRatesOutput{{Amount: 50, Start: "2024-01-01T00:00:00Z"}})
+
+	test := []struct {
+		name  string
+		entry timeentries.GetTimeEntriesOutput
+		want  float64
+	}{
+		{"workspace", timeentries.GetTimeEntriesOutput{UserId: 2}, 10},
+		{"workspace user", timeentries.GetTimeEntriesOutput{UserId: 1}, 20},
+		{"project without rate", timeentries.GetTimeEntriesOutput{UserId: 1, ProjectId: 200}, 20},
+		{"project", timeentries.GetTimeEntriesOutput{UserId: 1, ProjectId: 100}, 30},
+		{"project user", timeentries.GetTimeEntriesOutput{UserId: 2, ProjectId: 100}, 40},
+		{"task", timeentries.GetTimeEntriesOutput{UserId: 2, ProjectId: 100, TaskId: 1000}, 50},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := table.Rate(tt.entry); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBillableAmount(t *testing.T) {
+	table := rates.RateTable{Workspace: 10, Projects: map[int]float64{100: 30}}
+	entries := []timeentries.GetTimeEntriesOutput{
+		{Billable: true, Duration: 3600},
+		{Billable: true, Duration: 1800, ProjectId: 100},
+		{Billable: false, Duration: 3600, ProjectId: 100},
+		{Billable: true, Duration: -1, ProjectId: 100},
+	}
+	if got, want := table.BillableAmount(entries), 25.0; got != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestRateHistory(t *testing.T) {
+	userRate := 20.0
+	table := rates.NewRateTable(me.Workspace{DefaultHourlyRate: 10})
+	table.AddWorkspaceUsers([]workspaces.GetWorkspaceUsersOutput{{Id: 5, Uid: 1, Rate: &userRate}})
+	end := "2024-03-01T00:00:00Z"
+	table.AddRates(rates.LevelWorkspaceUser, 5, []rates.GetRatesOutput{
+		{Amount: 20, Start: end},
+		{Amount: 15, Start: "2024-01-01T00:00:00Z", End: &end},
+	})
+	projectEnd := "2024-02-01T00:00:00Z"
+	table.AddRates(rates.LevelProject, 100, []rates.GetRatesOutput{{Amount: 30, Start: "2024-01-01T00:00:00Z", End: &projectEnd}})
+
+	test := []struct {
+		name  string
+		entry timeentries.GetTimeEntriesOutput
+		want  float64
+	}{
+		{"current", timeentries.GetTimeEntriesOutput{UserId: 1, Start: "2024-05-15T09:00:00Z"}, 20},
+		{"previous", timeentries.GetTimeEntriesOutput{UserId: 1, Start: "2024-02-15T09:00:00Z"}, 15},
+		{"before the history", timeentries.GetTimeEntriesOutput{UserId: 1, Start: "2023-12-15T09:00:00Z"}, 10},
+		{"former project rate", timeentries.GetTimeEntriesOutput{UserId: 1, ProjectId: 100, Start: "2024-01-15T09:00:00Z"}, 30},
+		{"ended project rate", timeentries.GetTimeEntriesOutput{UserId: 1, ProjectId: 100, Start: "2024-05-15T09:00:00Z"}, 20},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := table.Rate(tt.entry); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
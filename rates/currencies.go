@@ -0,0 +1,59 @@
+package rates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const currenciesPath = "/api/v9/workspaces/%d/currencies"
+
+// GetCurrenciesInput contains the input data for GetCurrencies.
+type GetCurrenciesInput struct {
+	WorkspaceId int // required
+}
+
+// GetCurrenciesOutput represents a currency used in a workspace.
+type GetCurrenciesOutput struct {
+	Code       string `json:"code"` // ISO 4217 currency code
+	CurrencyId int    `json:"currency_id"`
+}
+
+// GetCurrencies retrieves the currencies of a workspace.
+func (c Client) GetCurrencies(input GetCurrenciesInput) ([]GetCurrenciesOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(currenciesPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetCurrenciesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gco := make([]GetCurrenciesOutput, 0)
+	if err := json.Unmarshal(body, &gco); err != nil {
+		return nil, err
+	}
+
+	return gco, nil
+}
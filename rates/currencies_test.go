@@ -0,0 +1,43 @@
+package rates_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/rates"
+)
+
+func TestGetCurrencies(t *testing.T) {
+	testFile := readTestFile(t, "testdata/rates/currencies.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   rates.Client
+		arg      rates.GetCurrenciesInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, rates.GetCurrenciesInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, rates.GetCurrenciesInput{}, errorWant, rates.ErrorRequiredParameter},
+		{"http error", errorClient, rates.GetCurrenciesInput{WorkspaceId: workspaceId}, errorWant, rates.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetCurrencies(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
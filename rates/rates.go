@@ -0,0 +1,152 @@
+package rates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	ratesPath       = "/api/v9/workspaces/%d/rates"
+	rateHistoryPath = "/api/v9/workspaces/%d/rates/%s/%d"
+)
+
+// Level represents the entity a rate applies to.
+type Level string
+
+const (
+	LevelWorkspace     Level = "workspace"
+	LevelWorkspaceUser Level = "workspace_user"
+	LevelProject       Level = "project"
+	LevelProjectUser   Level = "project_user"
+	LevelTask          Level = "task"
+)
+
+// Mode represents how a new rate is applied to the existing time entries.
+type Mode string
+
+const (
+	ModeStartToday      Mode = "start-today"      // Apply to time entries from today on
+	ModeOverrideCurrent Mode = "override-current" // Apply from the start of the current rate on
+	ModeOverrideAll     Mode = "override-all"     // Apply to all time entries
+)
+
+// GetRatesInput contains the input data for GetRates.
+type GetRatesInput struct {
+	WorkspaceId int   // required
+	Level       Level // required
+	LevelId     int   // required, ID of the workspace, workspace user, project, project user or task
+}
+
+// GetRatesOutput represents a rate valid during a period.
+type GetRatesOutput struct {
+	Amount          float64 `json:"amount"`
+	CreatedAt       string  `json:"created_at"`
+	CreatorId       int     `json:"creator_id"`
+	End             *string `json:"end"` // Null for the current rate
+	Id              int     `json:"id"`
+	ProjectId       *int    `json:"project_id"`
+	ProjectUserId   *int    `json:"project_user_id"`
+	Start           string  `json:"start"`
+	TaskId          *int    `json:"task_id"`
+	UpdatedAt       string  `json:"updated_at"`
+	WorkspaceId     int     `json:"workspace_id"`
+	WorkspaceUserId *int    `json:"workspace_user_id"`
+}
+
+// GetRates retrieves the rate history of a workspace, workspace user, project, project user or task.
+func (c Client) GetRates(input GetRatesInput) ([]GetRatesOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	if input.Level == "" {
+		slog.Error("Level is required")
+		return nil, ErrorRequiredParameter
+	}
+	if input.LevelId == 0 {
+		slog.Error("LevelId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(rateHistoryPath, input.WorkspaceId, input.Level, input.LevelId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetRatesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gro := make([]GetRatesOutput, 0)
+	if err := json.Unmarshal(body, &gro); err != nil {
+		return nil, err
+	}
+
+	return gro, nil
+}
+
+// PostRateBody represents the body of the request to set a rate.
+type PostRateBody struct {
+	Amount  float64 `json:"amount"`          // Hourly rate, required
+	Level   Level   `json:"level"`           // Entity the rate applies to, required
+	LevelId int     `json:"level_id"`        // ID of the entity, required
+	Mode    Mode    `json:"mode"`            // How the rate is applied to existing time entries, required
+	Start   *string `json:"start,omitempty"` // Start of the rate in RFC3339 format, optional
+}
+
+// PostRateInput contains the input data for PostRate.
+type PostRateInput struct {
+	WorkspaceId int // required
+	Body        PostRateBody
+}
+
+// PostRate sets a new rate of a workspace, workspace user, project, project user or task.
+func (c Client) PostRate(input PostRateInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(ratesPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package rates_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/rates"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) rates.Client {
+	return rates.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetRates(t *testing.T) {
+	testFile := readTestFile(t, "testdata/rates/rates.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	level := rates.LevelProject
+	levelId := 1234567890
+	test := []struct {
+		name     string
+		client   rates.Client
+		arg      rates.GetRatesInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, rates.GetRatesInput{WorkspaceId: workspaceId, Level: level, LevelId: levelId}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, rates.GetRatesInput{Level: level, LevelId: levelId}, errorWant, rates.ErrorRequiredParameter},
+		{"Level parameter error", successClient, rates.GetRatesInput{WorkspaceId: workspaceId, LevelId: levelId}, errorWant, rates.ErrorRequiredParameter},
+		{"LevelId parameter error", successClient, rates.GetRatesInput{WorkspaceId: workspaceId, Level: level}, errorWant, rates.ErrorRequiredParameter},
+		{"http error", errorClient, rates.GetRatesInput{WorkspaceId: workspaceId, Level: level, LevelId: levelId}, errorWant, rates.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetRates(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostRate(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	test := []struct {
+		name    string
+		client  rates.Client
+		arg     rates.PostRateInput
+		wantErr error
+	}{
+		{"success", successClient, rates.PostRateInput{WorkspaceId: workspaceId, Body: rates.PostRateBody{Amount: 100, Level: rates.LevelProject, LevelId: 1234567890, Mode: rates.ModeStartToday}}, nil},
+		{"parameter error", successClient, rates.PostRateInput{Body: rates.PostRateBody{Amount: 100, Level: rates.LevelProject, LevelId: 1234567890, Mode: rates.ModeStartToday}}, rates.ErrorRequiredParameter},
+		{"http error", errorClient, rates.PostRateInput{WorkspaceId: workspaceId, Body: rates.PostRateBody{Amount: 100, Level: rates.LevelProject, LevelId: 1234567890, Mode: rates.ModeStartToday}}, rates.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.PostRate(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
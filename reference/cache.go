@@ -0,0 +1,106 @@
+package reference
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is the default time reference data is kept by a Cache.
+const DefaultTTL = 24 * time.Hour
+
+// Cache keeps the reference data fetched by a Client in memory, so that it is
+// requested at most once per TTL. It is safe for concurrent use.
+type Cache struct {
+	client Client
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value     any
+	fetchedAt time.Time
+}
+
+// NewCache creates a new Cache fetching reference data with client.
+// The data is kept for ttl, or DefaultTTL if ttl is zero.
+func NewCache(client Client, ttl time.Duration) *Cache {
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{
+		client:  client,
+		ttl:     ttl,
+		entries: map[string]cacheEntry{},
+	}
+}
+
+// Timezones returns the names of the supported timezones.
+func (c *Cache) Timezones() ([]string, error) {
+	return cached(c, timezonesPath, c.client.GetTimezones)
+}
+
+// TimezoneOffsets returns the supported timezones with their UTC offsets.
+func (c *Cache) TimezoneOffsets() ([]GetTimezoneOffsetsOutput, error) {
+	return cached(c, timezoneOffsetsPath, c.client.GetTimezoneOffsets)
+}
+
+// Countries returns the countries known to Toggl.
+func (c *Cache) Countries() ([]GetCountriesOutput, error) {
+	return cached(c, countriesPath, c.client.GetCountries)
+}
+
+// Currencies returns the currencies supported by Toggl.
+func (c *Cache) Currencies() ([]GetCurrenciesOutput, error) {
+	return cached(c, currenciesPath, c.client.GetCurrencies)
+}
+
+// ValidTimezone reports whether name is a timezone supported by Toggl.
+func (c *Cache) ValidTimezone(name string) (bool, error) {
+	timezones, err := c.Timezones()
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(timezones, name), nil
+}
+
+// ValidCurrency reports whether code is the ISO 4217 code of a currency supported by Toggl.
+func (c *Cache) ValidCurrency(code string) (bool, error) {
+	currencies, err := c.Currencies()
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(currencies, func(cur GetCurrenciesOutput) bool {
+		return strings.EqualFold(cur.IsoCode, code)
+	}), nil
+}
+
+// Clear drops all the cached reference data.
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]cacheEntry{}
+}
+
+// cached returns the value stored under key, calling fetch if it is missing or expired.
+// Errors are not cached.
+func cached[T any](c *Cache, key string, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok && time.Since(e.fetchedAt) < c.ttl {
+		return e.value.(T), nil
+	}
+	v, err := fetch()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if c.entries == nil {
+		c.entries = map[string]cacheEntry{}
+	}
+	c.entries[key] = cacheEntry{value: v, fetchedAt: time.Now()}
+	return v, nil
+}
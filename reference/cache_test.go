@@ -0,0 +1,84 @@
+package reference_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/reference"
+)
+
+func countingClient(calls *int, status int, body string) reference.Client {
+	return reference.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				*calls++
+				return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+			},
+		},
+	}
+}
+
+func TestCache(t *testing.T) {
+	calls := 0
+	cache := reference.NewCache(countingClient(&calls, http.StatusOK, `["Asia/Tokyo","Europe/Tallinn"]`), 0)
+
+	for range 3 {
+		timezones, err := cache.Timezones()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(timezones) != 2 {
+			t.Errorf("Expected 2 timezones, got %v", timezones)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+
+	test := []struct {
+		name string
+		tz   string
+		want bool
+	}{
+		{"valid", "Asia/Tokyo", true},
+		{"invalid", "Mars/Olympus_Mons", false},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cache.ValidTimezone(tt.tz)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+
+	cache.Clear()
+	if _, err := cache.Timezones(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests after Clear, got %d", calls)
+	}
+}
+
+func TestCacheError(t *testing.T) {
+	calls := 0
+	cache := reference.NewCache(countingClient(&calls, http.StatusBadRequest, ""), 0)
+
+	for range 2 {
+		if _, err := cache.ValidCurrency("EUR"); err != reference.ErrorStatusNotOK {
+			t.Errorf("Expected error %v, got %v", reference.ErrorStatusNotOK, err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected errors not to be cached, got %d requests", calls)
+	}
+}
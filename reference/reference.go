@@ -0,0 +1,165 @@
+package reference
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	timezonesPath       = "/api/v9/timezones"
+	timezoneOffsetsPath = "/api/v9/timezones/offsets"
+	countriesPath       = "/api/v9/countries"
+	currenciesPath      = "/api/v9/currencies"
+)
+
+// GetTimezones retrieves the names of the timezones supported by Toggl.
+func (c Client) GetTimezones() ([]string, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = timezonesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []string{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gto := make([]string, 0)
+	if err := json.Unmarshal(body, &gto); err != nil {
+		return nil, err
+	}
+
+	return gto, nil
+}
+
+// GetTimezoneOffsetsOutput represents a supported timezone with its UTC offset.
+type GetTimezoneOffsetsOutput struct {
+	Name      string `json:"name"`
+	UtcOffset string `json:"utc_offset"` // E.g. "+09:00"
+}
+
+// GetTimezoneOffsets retrieves the supported timezones with their UTC offsets.
+func (c Client) GetTimezoneOffsets() ([]GetTimezoneOffsetsOutput, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = timezoneOffsetsPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetTimezoneOffsetsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gtoo := make([]GetTimezoneOffsetsOutput, 0)
+	if err := json.Unmarshal(body, &gtoo); err != nil {
+		return nil, err
+	}
+
+	return gtoo, nil
+}
+
+// GetCountriesOutput represents a country.
+type GetCountriesOutput struct {
+	CountryCode string `json:"country_code"` // ISO 3166-1 alpha-2 code
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+}
+
+// GetCountries retrieves the countries known to Toggl.
+func (c Client) GetCountries() ([]GetCountriesOutput, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = countriesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetCountriesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gco := make([]GetCountriesOutput, 0)
+	if err := json.Unmarshal(body, &gco); err != nil {
+		return nil, err
+	}
+
+	return gco, nil
+}
+
+// GetCurrenciesOutput represents a currency.
+type GetCurrenciesOutput struct {
+	CurrencyId int    `json:"currency_id"`
+	IsoCode    string `json:"iso_code"` // ISO 4217 code
+	Symbol     string `json:"symbol"`
+}
+
+// GetCurrencies retrieves the currencies supported by Toggl.
+func (c Client) GetCurrencies() ([]GetCurrenciesOutput, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = currenciesPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetCurrenciesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gco := make([]GetCurrenciesOutput, 0)
+	if err := json.Unmarshal(body, &gco); err != nil {
+		return nil, err
+	}
+
+	return gco, nil
+}
@@ -0,0 +1,11 @@
+package reports
+
+import (
+	"errors"
+)
+
+var (
+	ErrorStatusNotOK       = errors.New("error response status code")
+	ErrorRequiredParameter = errors.New("required parameter is missing")
+	ErrorUnsupportedFormat = errors.New("unsupported export format")
+)
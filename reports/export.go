@@ -0,0 +1,135 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// ExportFormat represents the file format of an exported report.
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+	ExportFormatPDF  ExportFormat = "pdf"
+)
+
+// contentTypes maps each export format to the media type sent in the Accept header.
+var contentTypes = map[ExportFormat]string{
+	ExportFormatCSV:  "text/csv",
+	ExportFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	ExportFormatPDF:  "application/pdf",
+}
+
+// ExportSummaryTimeEntriesInput contains the input data for ExportSummaryTimeEntries.
+type ExportSummaryTimeEntriesInput struct {
+	WorkspaceId int          // required
+	Format      ExportFormat // required
+	Body        PostSummaryTimeEntriesBody
+}
+
+// ExportSummaryTimeEntries exports a summary report and streams the file to w.
+func (c Client) ExportSummaryTimeEntries(input ExportSummaryTimeEntriesInput, w io.Writer) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.Body.StartDate == "" {
+		slog.Error("StartDate is required")
+		return ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return err
+	}
+	return c.export(fmt.Sprintf(summaryTimeEntriesPath, input.WorkspaceId), input.Format, j, w)
+}
+
+// ExportSearchTimeEntriesInput contains the input data for ExportSearchTimeEntries.
+type ExportSearchTimeEntriesInput struct {
+	WorkspaceId int          // required
+	Format      ExportFormat // required
+	Body        PostSearchTimeEntriesBody
+}
+
+// ExportSearchTimeEntries exports a detailed report and streams the file to w.
+// Unlike PostSearchTimeEntries, the export contains every row in a single response.
+func (c Client) ExportSearchTimeEntries(input ExportSearchTimeEntriesInput, w io.Writer) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.Body.StartDate == "" {
+		slog.Error("StartDate is required")
+		return ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return err
+	}
+	return c.export(fmt.Sprintf(searchTimeEntriesPath, input.WorkspaceId), input.Format, j, w)
+}
+
+// ExportWeeklyTimeEntriesInput contains the input data for ExportWeeklyTimeEntries.
+type ExportWeeklyTimeEntriesInput struct {
+	WorkspaceId int          // required
+	Format      ExportFormat // required
+	Body        PostWeeklyTimeEntriesBody
+}
+
+// ExportWeeklyTimeEntries exports a weekly report and streams the file to w.
+func (c Client) ExportWeeklyTimeEntries(input ExportWeeklyTimeEntriesInput, w io.Writer) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.Body.StartDate == "" {
+		slog.Error("StartDate is required")
+		return ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return err
+	}
+	return c.export(fmt.Sprintf(weeklyTimeEntriesPath, input.WorkspaceId), input.Format, j, w)
+}
+
+// export requests the report at path in the given format and copies the response body to w
+// without buffering it in memory.
+func (c Client) export(path string, format ExportFormat, body []byte, w io.Writer) error {
+	contentType, ok := contentTypes[format]
+	if !ok {
+		slog.Error(fmt.Sprintf("Unsupported export format: %v", format))
+		return ErrorUnsupportedFormat
+	}
+	toggl := c.Post(url.URL{}, body)
+	toggl.URL.Path = fmt.Sprintf("%s.%s", path, format)
+	toggl.Header.Set("Accept", contentType)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	default:
+		message, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(message)))
+		return ErrorStatusNotOK
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package reports_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/reports"
+)
+
+func exportClient(t *testing.T, status int, wantPath, wantAccept string) reports.Client {
+	return reports.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				if wantPath != "" && r.URL.Path != wantPath {
+					t.Errorf("Expected path %s, got %s", wantPath, r.URL.Path)
+				}
+				if wantAccept != "" && r.Header.Get("Accept") != wantAccept {
+					t.Errorf("Expected Accept %s, got %s", wantAccept, r.Header.Get("Accept"))
+				}
+				return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewBufferString("exported"))}, nil
+			},
+		},
+	}
+}
+
+func TestExportSummaryTimeEntries(t *testing.T) {
+	workspaceId := 123456789
+	body := reports.PostSummaryTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}}
+	test := []struct {
+		name    string
+		client  reports.Client
+		arg     reports.ExportSummaryTimeEntriesInput
+		want    string
+		wantErr error
+	}{
+		{"success", exportClient(t, http.StatusOK, "/reports/api/v3/workspace/123456789/summary/time_entries.csv", "text/csv"), reports.ExportSummaryTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatCSV, Body: body}, "exported", nil},
+		{"WorkspaceId parameter error", exportClient(t, http.StatusOK, "", ""), reports.ExportSummaryTimeEntriesInput{Format: reports.ExportFormatCSV, Body: body}, "", reports.ErrorRequiredParameter},
+		{"StartDate parameter error", exportClient(t, http.StatusOK, "", ""), reports.ExportSummaryTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatCSV}, "", reports.ErrorRequiredParameter},
+		{"format error", exportClient(t, http.StatusOK, "", ""), reports.ExportSummaryTimeEntriesInput{WorkspaceId: workspaceId, Format: "txt", Body: body}, "", reports.ErrorUnsupportedFormat},
+		{"http error", exportClient(t, http.StatusBadRequest, "", ""), reports.ExportSummaryTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatCSV, Body: body}, "", reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bytes.Buffer{}
+			err := tt.client.ExportSummaryTimeEntries(tt.arg, &got)
+			checkError(t, tt.wantErr, err)
+			if got.String() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestExportSearchTimeEntries(t *testing.T) {
+	workspaceId := 123456789
+	body := reports.PostSearchTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}}
+	test := []struct {
+		name    string
+		client  reports.Client
+		arg     reports.ExportSearchTimeEntriesInput
+		want    string
+		wantErr error
+	}{
+		{"success", exportClient(t, http.StatusOK, "/reports/api/v3/workspace/123456789/search/time_entries.xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"), reports.ExportSearchTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatXLSX, Body: body}, "exported", nil},
+		{"WorkspaceId parameter error", exportClient(t, http.StatusOK, "", ""), reports.ExportSearchTimeEntriesInput{Format: reports.ExportFormatXLSX, Body: body}, "", reports.ErrorRequiredParameter},
+		{"StartDate parameter error", exportClient(t, http.StatusOK, "", ""), reports.ExportSearchTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatXLSX}, "", reports.ErrorRequiredParameter},
+		{"format error", exportClient(t, http.StatusOK, "", ""), reports.ExportSearchTimeEntriesInput{WorkspaceId: workspaceId, Body: body}, "", reports.ErrorUnsupportedFormat},
+		{"http error", exportClient(t, http.StatusBadRequest, "", ""), reports.ExportSearchTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatXLSX, Body: body}, "", reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bytes.Buffer{}
+			err := tt.client.ExportSearchTimeEntries(tt.arg, &got)
+			checkError(t, tt.wantErr, err)
+			if got.String() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestExportWeeklyTimeEntries(t *testing.T) {
+	workspaceId := 123456789
+	body := reports.PostWeeklyTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}}
+	test := []struct {
+		name    string
+		client  reports.Client
+		arg     reports.ExportWeeklyTimeEntriesInput
+		want    string
+		wantErr error
+	}{
+		{"success", exportClient(t, http.StatusOK, "/reports/api/v3/workspace/123456789/weekly/time_entries.pdf", "application/pdf"), reports.ExportWeeklyTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatPDF, Body: body}, "exported", nil},
+		{"WorkspaceId parameter error", exportClient(t, http.StatusOK, "", ""), reports.ExportWeeklyTimeEntriesInput{Format: reports.ExportFormatPDF, Body: body}, "", reports.ErrorRequiredParameter},
+		{"StartDate parameter error", exportClient(t, http.StatusOK, "", ""), reports.ExportWeeklyTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatPDF}, "", reports.ErrorRequiredParameter},
+		{"format error", exportClient(t, http.StatusOK, "", ""), reports.ExportWeeklyTimeEntriesInput{WorkspaceId: workspaceId, Format: "txt", Body: body}, "", reports.ErrorUnsupportedFormat},
+		{"http error", exportClient(t, http.StatusBadRequest, "", ""), reports.ExportWeeklyTimeEntriesInput{WorkspaceId: workspaceId, Format: reports.ExportFormatPDF, Body: body}, "", reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bytes.Buffer{}
+			err := tt.client.ExportWeeklyTimeEntries(tt.arg, &got)
+			checkError(t, tt.wantErr, err)
+			if got.String() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got.String())
+			}
+		})
+	}
+}
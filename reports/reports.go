@@ -0,0 +1,296 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	summaryTimeEntriesPath = "/reports/api/v3/workspace/%d/summary/time_entries"
+	searchTimeEntriesPath  = "/reports/api/v3/workspace/%d/search/time_entries"
+	weeklyTimeEntriesPath  = "/reports/api/v3/workspace/%d/weekly/time_entries"
+)
+
+// Grouping represents how the rows of a summary report are grouped.
+type Grouping string
+
+const (
+	GroupingProjects    Grouping = "projects"
+	GroupingClients     Grouping = "clients"
+	GroupingUsers       Grouping = "users"
+	GroupingTasks       Grouping = "tasks"
+	GroupingTimeEntries Grouping = "time_entries"
+)
+
+// Filters represents the filters shared by every report.
+type Filters struct {
+	StartDate       string  `json:"start_date"`                 // Start date, YYYY-MM-DD, required
+	EndDate         string  `json:"end_date,omitempty"`         // End date, YYYY-MM-DD
+	Billable        *bool   `json:"billable,omitempty"`         // Whether the time entry is set as billable, optional, premium feature.
+	ClientIds       []int   `json:"client_ids,omitempty"`       // Client IDs, optional
+	Description     *string `json:"description,omitempty"`      // Description, optional
+	GroupIds        []int   `json:"group_ids,omitempty"`        // Group IDs, optional
+	ProjectIds      []int   `json:"project_ids,omitempty"`      // Project IDs, optional
+	Rounding        *int    `json:"rounding,omitempty"`         // Whether time should be rounded, optional
+	RoundingMinutes *int    `json:"rounding_minutes,omitempty"` // Rounding minutes value, optional, should be 0, 1, 5, 6, 10, 12, 15, 30, 60 or 240
+	TagIds          []int   `json:"tag_ids,omitempty"`          // Tag IDs, optional
+	TaskIds         []int   `json:"task_ids,omitempty"`         // Task IDs, optional
+	UserIds         []int   `json:"user_ids,omitempty"`         // User IDs, optional
+}
+
+// PostSummaryTimeEntriesBody represents the body of the request to load a summary report.
+type PostSummaryTimeEntriesBody struct {
+	Filters
+	Grouping            Grouping `json:"grouping,omitempty"`               // Grouping option, optional
+	SubGrouping         Grouping `json:"sub_grouping,omitempty"`           // SubGrouping option, optional
+	IncludeTimeEntryIds bool     `json:"include_time_entry_ids,omitempty"` // Whether time entry IDs should be included in the results
+}
+
+// PostSummaryTimeEntriesInput contains the input data for PostSummaryTimeEntries.
+type PostSummaryTimeEntriesInput struct {
+	WorkspaceId int // required
+	Body        PostSummaryTimeEntriesBody
+}
+
+// SummarySubGroup represents a sub group of a summary report.
+type SummarySubGroup struct {
+	Id      *int   `json:"id"`
+	Ids     []int  `json:"ids"` // Time entry IDs, only with IncludeTimeEntryIds
+	Seconds int    `json:"seconds"`
+	Title   string `json:"title"`
+}
+
+// SummaryGroup represents a group of a summary report.
+type SummaryGroup struct {
+	Id        *int              `json:"id"`
+	SubGroups []SummarySubGroup `json:"sub_groups"`
+}
+
+// PostSummaryTimeEntriesOutput represents a summary report.
+type PostSummaryTimeEntriesOutput struct {
+	Groups []SummaryGroup `json:"groups"`
+}
+
+// PostSummaryTimeEntries loads a summary report of the time entries matching the filters.
+func (c Client) PostSummaryTimeEntries(input PostSummaryTimeEntriesInput) (PostSummaryTimeEntriesOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostSummaryTimeEntriesOutput{}, ErrorRequiredParameter
+	}
+	if input.Body.StartDate == "" {
+		slog.Error("StartDate is required")
+		return PostSummaryTimeEntriesOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostSummaryTimeEntriesOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(summaryTimeEntriesPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostSummaryTimeEntriesOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostSummaryTimeEntriesOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostSummaryTimeEntriesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostSummaryTimeEntriesOutput{}, ErrorStatusNotOK
+	}
+
+	psteo := PostSummaryTimeEntriesOutput{}
+	if err := json.Unmarshal(body, &psteo); err != nil {
+		return PostSummaryTimeEntriesOutput{}, err
+	}
+
+	return psteo, nil
+}
+
+// PostSearchTimeEntriesBody represents the body of the request to load a detailed report.
+type PostSearchTimeEntriesBody struct {
+	Filters
+	FirstRowNumber *int   `json:"first_row_number,omitempty"` // Row number to start from, the following pages are requested automatically
+	Grouped        bool   `json:"grouped,omitempty"`          // Whether time entries should be grouped, optional, default false
+	OrderBy        string `json:"order_by,omitempty"`         // Order by field, optional, default "date". Can be "date", "user", "duration", "description" or "last_update"
+	OrderDir       string `json:"order_dir,omitempty"`        // Order direction, optional. Can be ASC or DESC
+	PageSize       *int   `json:"page_size,omitempty"`        // PageSize defines the number of items per page, optional, default 50
+}
+
+// PostSearchTimeEntriesInput contains the input data for PostSearchTimeEntries.
+type PostSearchTimeEntriesInput struct {
+	WorkspaceId int // required
+	Body        PostSearchTimeEntriesBody
+}
+
+// SearchTimeEntry represents a single time entry of a detailed report row.
+type SearchTimeEntry struct {
+	At      string `json:"at"`
+	Id      int    `json:"id"`
+	Seconds int    `json:"seconds"`
+	Start   string `json:"start"`
+	Stop    string `json:"stop"`
+}
+
+// PostSearchTimeEntriesOutput represents a row of a detailed report.
+type PostSearchTimeEntriesOutput struct {
+	Billable              bool              `json:"billable"`
+	BillableAmountInCents *int              `json:"billable_amount_in_cents"`
+	Currency              string            `json:"currency"`
+	Description           string            `json:"description"`
+	HourlyRateInCents     *int              `json:"hourly_rate_in_cents"`
+	ProjectId             *int              `json:"project_id"`
+	RowNumber             int               `json:"row_number"`
+	TagIds                []int             `json:"tag_ids"`
+	TaskId                *int              `json:"task_id"`
+	TimeEntries           []SearchTimeEntry `json:"time_entries"`
+	UserId                int               `json:"user_id"`
+	Username              string            `json:"username"`
+}
+
+// PostSearchTimeEntries loads a detailed report of the time entries matching the filters.
+// Every page is requested, following the next row number returned by the API.
+func (c Client) PostSearchTimeEntries(input PostSearchTimeEntriesInput) ([]PostSearchTimeEntriesOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	if input.Body.StartDate == "" {
+		slog.Error("StartDate is required")
+		return nil, ErrorRequiredParameter
+	}
+
+	pseo := make([]PostSearchTimeEntriesOutput, 0)
+	reqBody := input.Body
+	for {
+		j, err := json.Marshal(reqBody)
+		if err != nil {
+			return nil, err
+		}
+		toggl := c.Post(url.URL{}, j)
+		toggl.URL.Path = fmt.Sprintf(searchTimeEntriesPath, input.WorkspaceId)
+
+		resp, err := c.HttpClient.Do(&toggl)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+		case http.StatusNotFound:
+			return pseo, nil
+		default:
+			slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+			return nil, ErrorStatusNotOK
+		}
+
+		page := make([]PostSearchTimeEntriesOutput, 0)
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+		pseo = append(pseo, page...)
+
+		next := resp.Header.Get("X-Next-Row-Number")
+		if next == "" || len(page) == 0 {
+			break
+		}
+		nextRowNumber, err := strconv.Atoi(next)
+		if err != nil {
+			return nil, err
+		}
+		// A next row number that does not advance would request the same page forever.
+		requested := 1
+		if reqBody.FirstRowNumber != nil {
+			requested = *reqBody.FirstRowNumber
+		}
+		if nextRowNumber <= requested {
+			break
+		}
+		reqBody.FirstRowNumber = &nextRowNumber
+	}
+
+	return pseo, nil
+}
+
+// PostWeeklyTimeEntriesBody represents the body of the request to load a weekly report.
+type PostWeeklyTimeEntriesBody struct {
+	Filters
+}
+
+// PostWeeklyTimeEntriesInput contains the input data for PostWeeklyTimeEntries.
+type PostWeeklyTimeEntriesInput struct {
+	WorkspaceId int // required
+	Body        PostWeeklyTimeEntriesBody
+}
+
+// PostWeeklyTimeEntriesOutput represents a row of a weekly report.
+// Seconds and BillableAmountsInCents hold one value per day of the week.
+type PostWeeklyTimeEntriesOutput struct {
+	BillableAmountsInCents []int  `json:"billable_amounts_in_cents"`
+	Currency               string `json:"currency"`
+	HourlyRateInCents      *int   `json:"hourly_rate_in_cents"`
+	ProjectId              *int   `json:"project_id"`
+	Seconds                []int  `json:"seconds"`
+	UserId                 int    `json:"user_id"`
+}
+
+// PostWeeklyTimeEntries loads a weekly report of the time entries matching the filters.
+func (c Client) PostWeeklyTimeEntries(input PostWeeklyTimeEntriesInput) ([]PostWeeklyTimeEntriesOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	if input.Body.StartDate == "" {
+		slog.Error("StartDate is required")
+		return nil, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(weeklyTimeEntriesPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []PostWeeklyTimeEntriesOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	pwteo := make([]PostWeeklyTimeEntriesOutput, 0)
+	if err := json.Unmarshal(body, &pwteo); err != nil {
+		return nil, err
+	}
+
+	return pwteo, nil
+}
@@ -0,0 +1,238 @@
+package reports_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/reports"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) reports.Client {
+	return reports.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestPostSummaryTimeEntries(t *testing.T) {
+	testFile := readTestFile(t, "testdata/reports/summary_time_entries.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(reports.PostSummaryTimeEntriesOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	body := reports.PostSummaryTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}, Grouping: reports.GroupingProjects}
+	test := []struct {
+		name     string
+		client   reports.Client
+		arg      reports.PostSummaryTimeEntriesInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, reports.PostSummaryTimeEntriesInput{WorkspaceId: workspaceId, Body: body}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, reports.PostSummaryTimeEntriesInput{Body: body}, errorWant, reports.ErrorRequiredParameter},
+		{"Body parameter error", successClient, reports.PostSummaryTimeEntriesInput{WorkspaceId: workspaceId}, errorWant, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.PostSummaryTimeEntriesInput{WorkspaceId: workspaceId, Body: body}, errorWant, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostSummaryTimeEntries(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostSearchTimeEntries(t *testing.T) {
+	testFile := readTestFile(t, "testdata/reports/search_time_entries.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	body := reports.PostSearchTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}}
+	test := []struct {
+		name     string
+		client   reports.Client
+		arg      reports.PostSearchTimeEntriesInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, reports.PostSearchTimeEntriesInput{WorkspaceId: workspaceId, Body: body}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, reports.PostSearchTimeEntriesInput{Body: body}, errorWant, reports.ErrorRequiredParameter},
+		{"Body parameter error", successClient, reports.PostSearchTimeEntriesInput{WorkspaceId: workspaceId}, errorWant, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.PostSearchTimeEntriesInput{WorkspaceId: workspaceId, Body: body}, errorWant, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostSearchTimeEntries(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostWeeklyTimeEntries(t *testing.T) {
+	testFile := readTestFile(t, "testdata/reports/weekly_time_entries.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	body := reports.PostWeeklyTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}}
+	test := []struct {
+		name     string
+		client   reports.Client
+		arg      reports.PostWeeklyTimeEntriesInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, reports.PostWeeklyTimeEntriesInput{WorkspaceId: workspaceId, Body: body}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, reports.PostWeeklyTimeEntriesInput{Body: body}, errorWant, reports.ErrorRequiredParameter},
+		{"Body parameter error", successClient, reports.PostWeeklyTimeEntriesInput{WorkspaceId: workspaceId}, errorWant, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.PostWeeklyTimeEntriesInput{WorkspaceId: workspaceId, Body: body}, errorWant, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostWeeklyTimeEntries(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostSearchTimeEntriesPagination(t *testing.T) {
+	pages := []string{`[{"row_number":1}]`, `[{"row_number":2}]`}
+	requests := make([]map[string]any, 0)
+	client := reports.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				reqBody := map[string]any{}
+				if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+					t.Fatal(err)
+				}
+				requests = append(requests, reqBody)
+				header := http.Header{}
+				if len(requests) < len(pages) {
+					header.Set("X-Next-Row-Number", "2")
+				}
+				page := pages[len(requests)-1]
+				return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(bytes.NewBufferString(page))}, nil
+			},
+		},
+	}
+
+	got, err := client.PostSearchTimeEntries(reports.PostSearchTimeEntriesInput{
+		WorkspaceId: 123456789,
+		Body:        reports.PostSearchTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].RowNumber != 1 || got[1].RowNumber != 2 {
+		t.Errorf("unexpected rows: %v", got)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(requests))
+	}
+	if _, ok := requests[0]["first_row_number"]; ok {
+		t.Errorf("first request should not contain first_row_number: %v", requests[0])
+	}
+	if requests[1]["first_row_number"] != float64(2) {
+		t.Errorf("Expected first_row_number 2, got %v", requests[1]["first_row_number"])
+	}
+}
+
+func TestPostSearchTimeEntriesPaginationStuck(t *testing.T) {
+	requests := 0
+	client := reports.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				requests++
+				if requests > 3 {
+					t.Fatal("Expected the pagination to stop")
+				}
+				header := http.Header{}
+				header.Set("X-Next-Row-Number", "2")
+				return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(bytes.NewBufferString(`[{"row_number":1}]`))}, nil
+			},
+		},
+	}
+
+	// The next row number does not advance after the second page.
+	got, err := client.PostSearchTimeEntries(reports.PostSearchTimeEntriesInput{
+		WorkspaceId: 123456789,
+		Body:        reports.PostSearchTimeEntriesBody{Filters: reports.Filters{StartDate: "2024-01-01"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || requests != 2 {
+		t.Errorf("Expected 2 rows in 2 requests, got %d rows in %d requests", len(got), requests)
+	}
+}
@@ -0,0 +1,315 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	savedReportsPath = "/reports/api/v3/workspace/%d/reports"
+	savedReportPath  = "/reports/api/v3/workspace/%d/reports/%d"
+	sharedReportPath = "/reports/api/v3/shared/%s"
+)
+
+// ReportType represents the kind of a saved report.
+type ReportType string
+
+const (
+	ReportTypeSummary  ReportType = "summary"
+	ReportTypeDetailed ReportType = "detailed"
+	ReportTypeWeekly   ReportType = "weekly"
+)
+
+// SavedReportParams represents the filters and options stored with a saved report.
+type SavedReportParams struct {
+	Filters
+	Grouping    Grouping `json:"grouping,omitempty"`     // Grouping option, summary reports only
+	SubGrouping Grouping `json:"sub_grouping,omitempty"` // SubGrouping option, summary reports only
+	OrderBy     string   `json:"order_by,omitempty"`     // Order by field, detailed reports only
+	OrderDir    string   `json:"order_dir,omitempty"`    // Order direction, detailed reports only
+}
+
+// SummaryBody builds the body of a summary report from the saved parameters.
+func (p SavedReportParams) SummaryBody() PostSummaryTimeEntriesBody {
+	return PostSummaryTimeEntriesBody{
+		Filters:     p.Filters,
+		Grouping:    p.Grouping,
+		SubGrouping: p.SubGrouping,
+	}
+}
+
+// SearchBody builds the body of a detailed report from the saved parameters.
+func (p SavedReportParams) SearchBody() PostSearchTimeEntriesBody {
+	return PostSearchTimeEntriesBody{
+		Filters:  p.Filters,
+		OrderBy:  p.OrderBy,
+		OrderDir: p.OrderDir,
+	}
+}
+
+// WeeklyBody builds the body of a weekly report from the saved parameters.
+func (p SavedReportParams) WeeklyBody() PostWeeklyTimeEntriesBody {
+	return PostWeeklyTimeEntriesBody{Filters: p.Filters}
+}
+
+// GetSavedReportsInput contains the input data for GetSavedReports.
+type GetSavedReportsInput struct {
+	WorkspaceId int // required
+}
+
+// GetSavedReportsOutput represents a saved report.
+type GetSavedReportsOutput struct {
+	CreatedAt      string            `json:"created_at"`
+	FixedDaterange bool              `json:"fixed_daterange"`
+	Id             int               `json:"id"`
+	Name           string            `json:"name"`
+	Params         SavedReportParams `json:"params"`
+	Public         bool              `json:"public"`
+	ReportType     ReportType        `json:"report_type"`
+	Token          string            `json:"token"` // Token of the shared link, only for public reports
+	UpdatedAt      string            `json:"updated_at"`
+	WorkspaceId    int               `json:"workspace_id"`
+}
+
+// GetSavedReports retrieves the saved reports of a workspace.
+func (c Client) GetSavedReports(input GetSavedReportsInput) ([]GetSavedReportsOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(savedReportsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetSavedReportsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gsro := make([]GetSavedReportsOutput, 0)
+	if err := json.Unmarshal(body, &gsro); err != nil {
+		return nil, err
+	}
+
+	return gsro, nil
+}
+
+// PostSavedReportBody represents the body of the request to create a saved report.
+type PostSavedReportBody struct {
+	FixedDaterange bool              `json:"fixed_daterange,omitempty"` // Whether the date range is fixed instead of relative to today
+	Name           string            `json:"name"`                      // Report name, required
+	Params         SavedReportParams `json:"params"`                    // Filters and options of the report
+	Public         bool              `json:"public,omitempty"`          // Whether the report can be fetched with its token
+	ReportType     ReportType        `json:"report_type"`               // Report type, required
+}
+
+// PostSavedReportInput contains the input data for PostSavedReport.
+type PostSavedReportInput struct {
+	WorkspaceId int // required
+	Body        PostSavedReportBody
+}
+
+// PostSavedReportOutput represents the response after creating a saved report.
+type PostSavedReportOutput = GetSavedReportsOutput
+
+// PostSavedReport creates a new saved report in a workspace.
+func (c Client) PostSavedReport(input PostSavedReportInput) (PostSavedReportOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostSavedReportOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostSavedReportOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(savedReportsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostSavedReportOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostSavedReportOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostSavedReportOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostSavedReportOutput{}, ErrorStatusNotOK
+	}
+
+	psro := PostSavedReportOutput{}
+	if err := json.Unmarshal(body, &psro); err != nil {
+		return PostSavedReportOutput{}, err
+	}
+
+	return psro, nil
+}
+
+// PutSavedReportBody represents the body of the request to update a saved report.
+type PutSavedReportBody = PostSavedReportBody
+
+// PutSavedReportInput contains the input data for PutSavedReport.
+type PutSavedReportInput struct {
+	WorkspaceId int // required
+	ReportId    int // required
+	Body        PutSavedReportBody
+}
+
+// PutSavedReportOutput represents the response after updating a saved report.
+type PutSavedReportOutput = GetSavedReportsOutput
+
+// PutSavedReport updates an existing saved report.
+func (c Client) PutSavedReport(input PutSavedReportInput) (PutSavedReportOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutSavedReportOutput{}, ErrorRequiredParameter
+	}
+	if input.ReportId == 0 {
+		slog.Error("ReportId is required")
+		return PutSavedReportOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutSavedReportOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(savedReportPath, input.WorkspaceId, input.ReportId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutSavedReportOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutSavedReportOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutSavedReportOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutSavedReportOutput{}, ErrorStatusNotOK
+	}
+
+	psro := PutSavedReportOutput{}
+	if err := json.Unmarshal(body, &psro); err != nil {
+		return PutSavedReportOutput{}, err
+	}
+
+	return psro, nil
+}
+
+// DeleteSavedReportInput contains the input data for DeleteSavedReport.
+type DeleteSavedReportInput struct {
+	WorkspaceId int // required
+	ReportId    int // required
+}
+
+// DeleteSavedReport deletes a saved report.
+func (c Client) DeleteSavedReport(input DeleteSavedReportInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.ReportId == 0 {
+		slog.Error("ReportId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(savedReportPath, input.WorkspaceId, input.ReportId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// GetSharedReportInput contains the input data for GetSharedReport.
+type GetSharedReportInput struct {
+	ReportToken string // required
+}
+
+// GetSharedReportOutput represents a saved report fetched through its shared link.
+type GetSharedReportOutput = GetSavedReportsOutput
+
+// GetSharedReport retrieves a public saved report by the token of its shared link.
+// The returned Params can be passed to the report methods through SummaryBody, SearchBody or WeeklyBody.
+func (c Client) GetSharedReport(input GetSharedReportInput) (GetSharedReportOutput, error) {
+	if input.ReportToken == "" {
+		slog.Error("ReportToken is required")
+		return GetSharedReportOutput{}, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	// The token is escaped once, in RawPath. Escaping it in Path would escape it twice.
+	toggl.URL.Path = fmt.Sprintf(sharedReportPath, input.ReportToken)
+	toggl.URL.RawPath = fmt.Sprintf(sharedReportPath, url.PathEscape(input.ReportToken))
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return GetSharedReportOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return GetSharedReportOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return GetSharedReportOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return GetSharedReportOutput{}, ErrorStatusNotOK
+	}
+
+	gsro := GetSharedReportOutput{}
+	if err := json.Unmarshal(body, &gsro); err != nil {
+		return GetSharedReportOutput{}, err
+	}
+
+	return gsro, nil
+}
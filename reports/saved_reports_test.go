@@ -0,0 +1,207 @@
+package reports_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/reports"
+)
+
+func TestGetSavedReports(t *testing.T) {
+	testFile := readTestFile(t, "testdata/reports/saved_reports.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   reports.Client
+		arg      reports.GetSavedReportsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, reports.GetSavedReportsInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, reports.GetSavedReportsInput{}, errorWant, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.GetSavedReportsInput{WorkspaceId: workspaceId}, errorWant, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetSavedReports(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostSavedReport(t *testing.T) {
+	testFile := readTestFile(t, "testdata/reports/saved_report.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(reports.PostSavedReportOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   reports.Client
+		arg      reports.PostSavedReportInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, reports.PostSavedReportInput{WorkspaceId: workspaceId, Body: reports.PostSavedReportBody{Name: "name", ReportType: reports.ReportTypeSummary}}, testFile, nil},
+		{"parameter error", successClient, reports.PostSavedReportInput{Body: reports.PostSavedReportBody{Name: "name", ReportType: reports.ReportTypeSummary}}, errorWant, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.PostSavedReportInput{WorkspaceId: workspaceId, Body: reports.PostSavedReportBody{Name: "name", ReportType: reports.ReportTypeSummary}}, errorWant, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostSavedReport(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutSavedReport(t *testing.T) {
+	testFile := readTestFile(t, "testdata/reports/saved_report.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(reports.PutSavedReportOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	reportId := 1234567890
+	test := []struct {
+		name     string
+		client   reports.Client
+		arg      reports.PutSavedReportInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, reports.PutSavedReportInput{WorkspaceId: workspaceId, ReportId: reportId, Body: reports.PutSavedReportBody{Name: "name", ReportType: reports.ReportTypeDetailed}}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, reports.PutSavedReportInput{ReportId: reportId, Body: reports.PutSavedReportBody{Name: "name", ReportType: reports.ReportTypeDetailed}}, errorWant, reports.ErrorRequiredParameter},
+		{"ReportId parameter error", successClient, reports.PutSavedReportInput{WorkspaceId: workspaceId, Body: reports.PutSavedReportBody{Name: "name", ReportType: reports.ReportTypeDetailed}}, errorWant, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.PutSavedReportInput{WorkspaceId: workspaceId, ReportId: reportId, Body: reports.PutSavedReportBody{Name: "name", ReportType: reports.ReportTypeDetailed}}, errorWant, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutSavedReport(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteSavedReport(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	reportId := 1234567890
+	test := []struct {
+		name    string
+		client  reports.Client
+		arg     reports.DeleteSavedReportInput
+		wantErr error
+	}{
+		{"success", successClient, reports.DeleteSavedReportInput{WorkspaceId: workspaceId, ReportId: reportId}, nil},
+		{"WorkspaceId parameter error", successClient, reports.DeleteSavedReportInput{ReportId: reportId}, reports.ErrorRequiredParameter},
+		{"ReportId parameter error", successClient, reports.DeleteSavedReportInput{WorkspaceId: workspaceId}, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.DeleteSavedReportInput{WorkspaceId: workspaceId, ReportId: reportId}, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteSavedReport(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetSharedReport(t *testing.T) {
+	testFile := readTestFile(t, "testdata/reports/saved_report.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(reports.GetSharedReportOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reportToken := "token"
+	test := []struct {
+		name     string
+		client   reports.Client
+		arg      reports.GetSharedReportInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, reports.GetSharedReportInput{ReportToken: reportToken}, testFile, nil},
+		{"parameter error", successClient, reports.GetSharedReportInput{}, errorWant, reports.ErrorRequiredParameter},
+		{"http error", errorClient, reports.GetSharedReportInput{ReportToken: reportToken}, errorWant, reports.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetSharedReport(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestSavedReportParams(t *testing.T) {
+	params := reports.SavedReportParams{
+		Filters:     reports.Filters{StartDate: "2024-01-01", ProjectIds: []int{1}},
+		Grouping:    reports.GroupingProjects,
+		SubGrouping: reports.GroupingTimeEntries,
+		OrderBy:     "date",
+		OrderDir:    "DESC",
+	}
+
+	summary := params.SummaryBody()
+	if summary.StartDate != "2024-01-01" || summary.Grouping != reports.GroupingProjects || summary.SubGrouping != reports.GroupingTimeEntries {
+		t.Errorf("unexpected summary body: %+v", summary)
+	}
+	search := params.SearchBody()
+	if search.ProjectIds[0] != 1 || search.OrderBy != "date" || search.OrderDir != "DESC" {
+		t.Errorf("unexpected search body: %+v", search)
+	}
+	weekly := params.WeeklyBody()
+	if weekly.StartDate != "2024-01-01" {
+		t.Errorf("unexpected weekly body: %+v", weekly)
+	}
+}
+
+func TestGetSharedReportEscape(t *testing.T) {
+	var got string
+	client := reports.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				got = r.URL.EscapedPath()
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString("{}"))}, nil
+			},
+		},
+	}
+	if _, err := client.GetSharedReport(reports.GetSharedReportInput{ReportToken: "ab/c d?"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/reports/api/v3/shared/ab%2Fc%20d%3F"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,25 @@
+package timeentries
+
+import (
+	"time"
+
+	"github.com/dev-shimada/toggl-go/favorites"
+)
+
+// StartFromFavorite builds the body of a running time entry starting at start from the
+// project, task, tags, billable flag and description of a favorite.
+// The result can be passed to PostTimeEntries with the favorite's workspace ID.
+func StartFromFavorite(favorite favorites.GetFavoritesOutput, createdWith string, start time.Time) PostTimeEntriesBody {
+	s := start.UTC().Format(time.RFC3339)
+	return PostTimeEntriesBody{
+		Billable:    favorite.Billable,
+		CreatedWith: createdWith,
+		Description: favorite.Description,
+		Duration:    -1,
+		ProjectId:   favorite.ProjectId,
+		Start:       &s,
+		TagIds:      favorite.TagIds,
+		TaskId:      favorite.TaskId,
+		WorkspaceId: favorite.WorkspaceId,
+	}
+}
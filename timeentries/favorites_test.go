@@ -0,0 +1,40 @@
+package timeentries_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/favorites"
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStartFromFavorite(t *testing.T) {
+	favorite := favorites.GetFavoritesOutput{
+		Billable:    true,
+		Description: "description",
+		FavoriteId:  1,
+		ProjectId:   2,
+		TagIds:      []int{3, 4},
+		TaskId:      5,
+		WorkspaceId: 6,
+	}
+	start := time.Date(2024, 5, 15, 19, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	got := timeentries.StartFromFavorite(favorite, "toggl-go", start)
+	s := "2024-05-15T10:00:00Z"
+	want := timeentries.PostTimeEntriesBody{
+		Billable:    true,
+		CreatedWith: "toggl-go",
+		Description: "description",
+		Duration:    -1,
+		ProjectId:   2,
+		Start:       &s,
+		TagIds:      []int{3, 4},
+		TaskId:      5,
+		WorkspaceId: 6,
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("diff: %v", cmp.Diff(want, got))
+	}
+}
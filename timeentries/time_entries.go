@@ -112,7 +112,7 @@ func (c Client) GetTimeEntries(tei GetTimeEntriesInput) ([]GetTimeEntriesOutput,
 		return []GetTimeEntriesOutput{}, nil
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return nil, ErrorStatusNotOK
+		return nil, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	gteo := make([]GetTimeEntriesOutput, 0)
@@ -147,7 +147,7 @@ func (c Client) GetCurrentTimeEntry() (GetCurrentTimeEntry, error) {
 		return GetCurrentTimeEntry{}, nil
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return GetCurrentTimeEntry{}, ErrorStatusNotOK
+		return GetCurrentTimeEntry{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	gcte := GetCurrentTimeEntry{}
@@ -201,7 +201,7 @@ func (c Client) GetATimeEntryById(input GetATimeEntryByIdInput) (GetATimeEntryBy
 		return GetATimeEntryByIdOutput{}, nil
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return GetATimeEntryByIdOutput{}, ErrorStatusNotOK
+		return GetATimeEntryByIdOutput{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	gatebio := GetATimeEntryByIdOutput{}
@@ -289,7 +289,7 @@ func (c Client) PostTimeEntries(input PostTimeEntriesInput) (PostTimeEntriesOutp
 		return PostTimeEntriesOutput{}, nil
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return PostTimeEntriesOutput{}, ErrorStatusNotOK
+		return PostTimeEntriesOutput{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	pteo := PostTimeEntriesOutput{}
@@ -372,7 +372,7 @@ func (c Client) PatchBulkEditingTimeEntries(input PatchBulkEditingTimeEntriesInp
 		return PatchBulkEditingTimeEntriesOutput{}, nil
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return PatchBulkEditingTimeEntriesOutput{}, ErrorStatusNotOK
+		return PatchBulkEditingTimeEntriesOutput{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	pbeto := PatchBulkEditingTimeEntriesOutput{}
@@ -438,7 +438,7 @@ func (c Client) PutTimeEntries(input PutTimeEntriesInput) (PutTimeEntriesOutput,
 		return PutTimeEntriesOutput{}, nil
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return PutTimeEntriesOutput{}, ErrorStatusNotOK
+		return PutTimeEntriesOutput{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	pbeto := PutTimeEntriesOutput{}
@@ -483,7 +483,7 @@ func (c Client) DeleteTimeEntries(input DeleteTimeEntriesInput) error {
 		return nil
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return ErrorStatusNotOK
+		return &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	return nil
@@ -524,7 +524,7 @@ func (c Client) PatchStopTimeEntry(input PatchStopTimeEntryInput) (PatchStopTime
 	case http.StatusOK:
 	default:
 		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
-		return PatchStopTimeEntryOutput{}, ErrorStatusNotOK
+		return PatchStopTimeEntryOutput{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 	psteo := PatchStopTimeEntryOutput{}
 	if err := json.Unmarshal(body, &psteo); err != nil {
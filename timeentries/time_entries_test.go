@@ -3,6 +3,7 @@ package timeentries_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -803,3 +804,15 @@ func TestGetTimeEntriesRemoteAccess(t *testing.T) {
 	- PUT TimeEntries
 	- DELETE TimeEntries
 */
+
+func TestStatusError(t *testing.T) {
+	client := fakeClient(&http.Response{StatusCode: http.StatusTooManyRequests, Body: http.NoBody})
+	_, err := client.GetCurrentTimeEntry()
+	if !errors.Is(err, timeentries.ErrorStatusNotOK) {
+		t.Fatalf("Expected %v, got %v", timeentries.ErrorStatusNotOK, err)
+	}
+	var se *timeentries.StatusError
+	if !errors.As(err, &se) || se.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected status code %d, got %v", http.StatusTooManyRequests, err)
+	}
+}
@@ -0,0 +1,275 @@
+package timesheets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	timesheetSetupsPath = "/api/v9/workspaces/%d/timesheet_setups"
+	timesheetSetupPath  = "/api/v9/workspaces/%d/timesheet_setups/%d"
+)
+
+// Periodicity represents the length of the periods covered by the timesheets of a setup.
+type Periodicity string
+
+const (
+	PeriodicityWeekly   Periodicity = "weekly"
+	PeriodicityBiweekly Periodicity = "biweekly"
+	PeriodicityMonthly  Periodicity = "monthly"
+)
+
+// GetTimesheetSetupsQuery represents the query parameters for fetching timesheet setups.
+type GetTimesheetSetupsQuery struct {
+	MemberIds   *string // Numeric member user IDs separated by comma
+	ApproverIds *string // Numeric approver user IDs separated by comma
+	SortField   *string // Possible values: member, approver, start_date
+	SortOrder   *string // Possible values: asc, desc
+}
+
+// GetTimesheetSetupsInput contains the input data for GetTimesheetSetups.
+type GetTimesheetSetupsInput struct {
+	WorkspaceId int // required
+	Query       GetTimesheetSetupsQuery
+}
+
+// GetTimesheetSetupsOutput represents the timesheet setup of a workspace member.
+type GetTimesheetSetupsOutput struct {
+	ApproverId           int         `json:"approver_id"`
+	EmailReminderDay     int         `json:"email_reminder_day"`
+	EmailReminderEnabled bool        `json:"email_reminder_enabled"`
+	EmailReminderTime    string      `json:"email_reminder_time"`
+	EndDate              *string     `json:"end_date"`
+	Id                   int         `json:"id"`
+	MemberId             int         `json:"member_id"`
+	Periodicity          Periodicity `json:"periodicity"`
+	SlackReminderDay     int         `json:"slack_reminder_day"`
+	SlackReminderEnabled bool        `json:"slack_reminder_enabled"`
+	SlackReminderTime    string      `json:"slack_reminder_time"`
+	StartDate            string      `json:"start_date"`
+	WorkspaceId          int         `json:"workspace_id"`
+}
+
+// GetTimesheetSetups retrieves the timesheet setups of a workspace.
+func (c Client) GetTimesheetSetups(input GetTimesheetSetupsInput) ([]GetTimesheetSetupsOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	gtsq := input.Query
+	q := url.Values{}
+	if gtsq.MemberIds != nil {
+		q.Add("member_ids", *gtsq.MemberIds)
+	}
+	if gtsq.ApproverIds != nil {
+		q.Add("approver_ids", *gtsq.ApproverIds)
+	}
+	if gtsq.SortField != nil {
+		q.Add("sort_field", *gtsq.SortField)
+	}
+	if gtsq.SortOrder != nil {
+		q.Add("sort_order", *gtsq.SortOrder)
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(timesheetSetupsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetTimesheetSetupsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gtso := make([]GetTimesheetSetupsOutput, 0)
+	if err := json.Unmarshal(body, &gtso); err != nil {
+		return nil, err
+	}
+
+	return gtso, nil
+}
+
+// PostTimesheetSetupBody represents the body of the request to create timesheet setups.
+type PostTimesheetSetupBody struct {
+	ApproverId           int         `json:"approver_id"`                      // User approving the timesheets, required
+	EmailReminderDay     int         `json:"email_reminder_day,omitempty"`     // Day of the period the email reminder is sent
+	EmailReminderEnabled bool        `json:"email_reminder_enabled,omitempty"` // Whether members are reminded by email to submit
+	EmailReminderTime    string      `json:"email_reminder_time,omitempty"`    // Time the email reminder is sent, HH:MM
+	MemberIds            []int       `json:"member_ids"`                       // Users whose timesheets are approved, required
+	Periodicity          Periodicity `json:"periodicity"`                      // Length of the timesheet periods, required
+	SlackReminderDay     int         `json:"slack_reminder_day,omitempty"`     // Day of the period the Slack reminder is sent
+	SlackReminderEnabled bool        `json:"slack_reminder_enabled,omitempty"` // Whether members are reminded on Slack to submit
+	SlackReminderTime    string      `json:"slack_reminder_time,omitempty"`    // Time the Slack reminder is sent, HH:MM
+	StartDate            string      `json:"start_date"`                       // First day of the first period, YYYY-MM-DD, required
+}
+
+// PostTimesheetSetupInput contains the input data for PostTimesheetSetup.
+type PostTimesheetSetupInput struct {
+	WorkspaceId int // required
+	Body        PostTimesheetSetupBody
+}
+
+// PostTimesheetSetupOutput represents the response after creating timesheet setups, one per member.
+type PostTimesheetSetupOutput = []GetTimesheetSetupsOutput
+
+// PostTimesheetSetup creates timesheet setups for the given members.
+func (c Client) PostTimesheetSetup(input PostTimesheetSetupInput) (PostTimesheetSetupOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(timesheetSetupsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostTimesheetSetupOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	ptso := make(PostTimesheetSetupOutput, 0)
+	if err := json.Unmarshal(body, &ptso); err != nil {
+		return nil, err
+	}
+
+	return ptso, nil
+}
+
+// PutTimesheetSetupBody represents the body of the request to update a timesheet setup.
+type PutTimesheetSetupBody struct {
+	ApproverId           *int    `json:"approver_id,omitempty"`            // User approving the timesheets
+	EmailReminderDay     *int    `json:"email_reminder_day,omitempty"`     // Day of the period the email reminder is sent
+	EmailReminderEnabled *bool   `json:"email_reminder_enabled,omitempty"` // Whether members are reminded by email to submit
+	EmailReminderTime    *string `json:"email_reminder_time,omitempty"`    // Time the email reminder is sent, HH:MM
+	EndDate              *string `json:"end_date,omitempty"`               // Last day of the setup, YYYY-MM-DD
+	SlackReminderDay     *int    `json:"slack_reminder_day,omitempty"`     // Day of the period the Slack reminder is sent
+	SlackReminderEnabled *bool   `json:"slack_reminder_enabled,omitempty"` // Whether members are reminded on Slack to submit
+	SlackReminderTime    *string `json:"slack_reminder_time,omitempty"`    // Time the Slack reminder is sent, HH:MM
+}
+
+// PutTimesheetSetupInput contains the input data for PutTimesheetSetup.
+type PutTimesheetSetupInput struct {
+	WorkspaceId      int // required
+	TimesheetSetupId int // required
+	Body             PutTimesheetSetupBody
+}
+
+// PutTimesheetSetupOutput represents the response after updating a timesheet setup.
+type PutTimesheetSetupOutput = GetTimesheetSetupsOutput
+
+// PutTimesheetSetup updates an existing timesheet setup.
+func (c Client) PutTimesheetSetup(input PutTimesheetSetupInput) (PutTimesheetSetupOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutTimesheetSetupOutput{}, ErrorRequiredParameter
+	}
+	if input.TimesheetSetupId == 0 {
+		slog.Error("TimesheetSetupId is required")
+		return PutTimesheetSetupOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutTimesheetSetupOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(timesheetSetupPath, input.WorkspaceId, input.TimesheetSetupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutTimesheetSetupOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutTimesheetSetupOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutTimesheetSetupOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutTimesheetSetupOutput{}, ErrorStatusNotOK
+	}
+
+	ptso := PutTimesheetSetupOutput{}
+	if err := json.Unmarshal(body, &ptso); err != nil {
+		return PutTimesheetSetupOutput{}, err
+	}
+
+	return ptso, nil
+}
+
+// DeleteTimesheetSetupInput contains the input data for DeleteTimesheetSetup.
+type DeleteTimesheetSetupInput struct {
+	WorkspaceId      int // required
+	TimesheetSetupId int // required
+}
+
+// DeleteTimesheetSetup deletes a timesheet setup.
+func (c Client) DeleteTimesheetSetup(input DeleteTimesheetSetupInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.TimesheetSetupId == 0 {
+		slog.Error("TimesheetSetupId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(timesheetSetupPath, input.WorkspaceId, input.TimesheetSetupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package timesheets_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/timesheets"
+)
+
+func TestGetTimesheetSetups(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet_setups.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.GetTimesheetSetupsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.GetTimesheetSetupsInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, timesheets.GetTimesheetSetupsInput{}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.GetTimesheetSetupsInput{WorkspaceId: workspaceId}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetTimesheetSetups(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostTimesheetSetup(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet_setups.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.PostTimesheetSetupInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.PostTimesheetSetupInput{WorkspaceId: workspaceId, Body: timesheets.PostTimesheetSetupBody{ApproverId: 1, MemberIds: []int{2, 3}, Periodicity: timesheets.PeriodicityWeekly, StartDate: "2024-01-01"}}, testFile, nil},
+		{"parameter error", successClient, timesheets.PostTimesheetSetupInput{Body: timesheets.PostTimesheetSetupBody{ApproverId: 1, MemberIds: []int{2, 3}, Periodicity: timesheets.PeriodicityWeekly, StartDate: "2024-01-01"}}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.PostTimesheetSetupInput{WorkspaceId: workspaceId, Body: timesheets.PostTimesheetSetupBody{ApproverId: 1, MemberIds: []int{2, 3}, Periodicity: timesheets.PeriodicityWeekly, StartDate: "2024-01-01"}}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostTimesheetSetup(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutTimesheetSetup(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet_setup.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(timesheets.PutTimesheetSetupOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	timesheetSetupId := 1234567890
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.PutTimesheetSetupInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.PutTimesheetSetupInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, timesheets.PutTimesheetSetupInput{TimesheetSetupId: timesheetSetupId}, errorWant, timesheets.ErrorRequiredParameter},
+		{"TimesheetSetupId parameter error", successClient, timesheets.PutTimesheetSetupInput{WorkspaceId: workspaceId}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.PutTimesheetSetupInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutTimesheetSetup(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteTimesheetSetup(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	timesheetSetupId := 1234567890
+	test := []struct {
+		name    string
+		client  timesheets.Client
+		arg     timesheets.DeleteTimesheetSetupInput
+		wantErr error
+	}{
+		{"success", successClient, timesheets.DeleteTimesheetSetupInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId}, nil},
+		{"WorkspaceId parameter error", successClient, timesheets.DeleteTimesheetSetupInput{TimesheetSetupId: timesheetSetupId}, timesheets.ErrorRequiredParameter},
+		{"TimesheetSetupId parameter error", successClient, timesheets.DeleteTimesheetSetupInput{WorkspaceId: workspaceId}, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.DeleteTimesheetSetupInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId}, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteTimesheetSetup(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package timesheets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	timesheetsPath = "/api/v9/workspaces/%d/timesheets"
+	timesheetPath  = "/api/v9/workspaces/%d/timesheets/%d/%s"
+)
+
+// Status represents the approval status of a timesheet.
+type Status string
+
+const (
+	StatusPending   Status = "pending"   // Not submitted yet, or reopened
+	StatusSubmitted Status = "submitted" // Waiting for approval
+	StatusApproved  Status = "approved"
+	StatusRejected  Status = "rejected"
+)
+
+// GetTimesheetsQuery represents the query parameters for fetching timesheets.
+type GetTimesheetsQuery struct {
+	StartDate         *string  // Timesheets of periods starting from this date, YYYY-MM-DD
+	EndDate           *string  // Timesheets of periods starting until this date, YYYY-MM-DD
+	Statuses          []Status // Only timesheets with these statuses
+	UserIds           *string  // Numeric user IDs separated by comma
+	TimesheetSetupIds *string  // Numeric timesheet setup IDs separated by comma
+	Page              *int     // Page number
+	PerPage           *int     // Number of items per page
+	SortField         *string  // Possible values: start_date, user, status
+	SortOrder         *string  // Possible values: asc, desc
+}
+
+// GetTimesheetsInput contains the input data for GetTimesheets.
+type GetTimesheetsInput struct {
+	WorkspaceId int // required
+	Query       GetTimesheetsQuery
+}
+
+// GetTimesheetsOutput represents the timesheet of a user for one period.
+type GetTimesheetsOutput struct {
+	ApprovedOrRejectedAt  *string     `json:"approved_or_rejected_at"`
+	ApprovedOrRejectedId  *int        `json:"approved_or_rejected_id"`
+	ApproverId            int         `json:"approver_id"`
+	EndDate               string      `json:"end_date"`
+	MemberId              int         `json:"member_id"`
+	Periodicity           Periodicity `json:"periodicity"`
+	RejectionComment      string      `json:"rejection_comment"`
+	StartDate             string      `json:"start_date"`
+	Status                Status      `json:"status"`
+	SubmittedAt           *string     `json:"submitted_at"`
+	Timezone              string      `json:"timezone"`
+	TimesheetSetupId      int         `json:"timesheet_setup_id"`
+	UserId                int         `json:"user_id"`
+	WorkingHoursInMinutes int         `json:"working_hours_in_minutes"`
+}
+
+// GetTimesheets retrieves the timesheets of a workspace.
+func (c Client) GetTimesheets(input GetTimesheetsInput) ([]GetTimesheetsOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	gtq := input.Query
+	q := url.Values{}
+	if gtq.StartDate != nil {
+		q.Add("start_date", *gtq.StartDate)
+	}
+	if gtq.EndDate != nil {
+		q.Add("end_date", *gtq.EndDate)
+	}
+	if len(gtq.Statuses) > 0 {
+		statuses := make([]string, 0, len(gtq.Statuses))
+		for _, s := range gtq.Statuses {
+			statuses = append(statuses, string(s))
+		}
+		q.Add("statuses", strings.Join(statuses, ","))
+	}
+	if gtq.UserIds != nil {
+		q.Add("user_ids", *gtq.UserIds)
+	}
+	if gtq.TimesheetSetupIds != nil {
+		q.Add("timesheet_setup_ids", *gtq.TimesheetSetupIds)
+	}
+	if gtq.Page != nil {
+		q.Add("page", fmt.Sprintf("%v", *gtq.Page))
+	}
+	if gtq.PerPage != nil {
+		q.Add("per_page", fmt.Sprintf("%v", *gtq.PerPage))
+	}
+	if gtq.SortField != nil {
+		q.Add("sort_field", *gtq.SortField)
+	}
+	if gtq.SortOrder != nil {
+		q.Add("sort_order", *gtq.SortOrder)
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(timesheetsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetTimesheetsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gto := make([]GetTimesheetsOutput, 0)
+	if err := json.Unmarshal(body, &gto); err != nil {
+		return nil, err
+	}
+
+	return gto, nil
+}
+
+// PutTimesheetBody represents the body of the request to update a timesheet.
+type PutTimesheetBody struct {
+	RejectionComment string `json:"rejection_comment,omitempty"` // Reason of the rejection, only with StatusRejected
+	Status           Status `json:"status"`                      // New status of the timesheet, required
+}
+
+// PutTimesheetInput contains the input data for PutTimesheet.
+type PutTimesheetInput struct {
+	WorkspaceId      int    // required
+	TimesheetSetupId int    // required
+	StartDate        string // required, start of the timesheet period, YYYY-MM-DD
+	Body             PutTimesheetBody
+}
+
+// PutTimesheetOutput represents the response after updating a timesheet.
+type PutTimesheetOutput = GetTimesheetsOutput
+
+// PutTimesheet changes the status of a timesheet.
+func (c Client) PutTimesheet(input PutTimesheetInput) (PutTimesheetOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutTimesheetOutput{}, ErrorRequiredParameter
+	}
+	if input.TimesheetSetupId == 0 {
+		slog.Error("TimesheetSetupId is required")
+		return PutTimesheetOutput{}, ErrorRequiredParameter
+	}
+	if input.StartDate == "" {
+		slog.Error("StartDate is required")
+		return PutTimesheetOutput{}, ErrorRequiredParameter
+	}
+	if input.Body.Status == "" {
+		slog.Error("Status is required")
+		return PutTimesheetOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutTimesheetOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(timesheetPath, input.WorkspaceId, input.TimesheetSetupId, input.StartDate)
+	toggl.URL.RawPath = fmt.Sprintf(timesheetPath, input.WorkspaceId, input.TimesheetSetupId, url.PathEscape(input.StartDate))
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutTimesheetOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutTimesheetOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutTimesheetOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutTimesheetOutput{}, ErrorStatusNotOK
+	}
+
+	pto := PutTimesheetOutput{}
+	if err := json.Unmarshal(body, &pto); err != nil {
+		return PutTimesheetOutput{}, err
+	}
+
+	return pto, nil
+}
+
+// TimesheetInput identifies a timesheet for SubmitTimesheet, ApproveTimesheet and ReopenTimesheet.
+type TimesheetInput struct {
+	WorkspaceId      int    // required
+	TimesheetSetupId int    // required
+	StartDate        string // required, start of the timesheet period, YYYY-MM-DD
+}
+
+func (input TimesheetInput) put(body PutTimesheetBody) PutTimesheetInput {
+	return PutTimesheetInput{
+		WorkspaceId:      input.WorkspaceId,
+		TimesheetSetupId: input.TimesheetSetupId,
+		StartDate:        input.StartDate,
+		Body:             body,
+	}
+}
+
+// SubmitTimesheet submits a timesheet for approval.
+func (c Client) SubmitTimesheet(input TimesheetInput) (PutTimesheetOutput, error) {
+	return c.PutTimesheet(input.put(PutTimesheetBody{Status: StatusSubmitted}))
+}
+
+// ApproveTimesheet approves a submitted timesheet.
+func (c Client) ApproveTimesheet(input TimesheetInput) (PutTimesheetOutput, error) {
+	return c.PutTimesheet(input.put(PutTimesheetBody{Status: StatusApproved}))
+}
+
+// RejectTimesheetInput contains the input data for RejectTimesheet.
+type RejectTimesheetInput struct {
+	TimesheetInput
+	Reason string // required
+}
+
+// RejectTimesheet rejects a submitted timesheet with a reason.
+func (c Client) RejectTimesheet(input RejectTimesheetInput) (PutTimesheetOutput, error) {
+	if input.Reason == "" {
+		slog.Error("Reason is required")
+		return PutTimesheetOutput{}, ErrorRequiredParameter
+	}
+	return c.PutTimesheet(input.put(PutTimesheetBody{Status: StatusRejected, RejectionComment: input.Reason}))
+}
+
+// ReopenTimesheet moves an approved or rejected timesheet back to pending so that it can be edited and submitted again.
+func (c Client) ReopenTimesheet(input TimesheetInput) (PutTimesheetOutput, error) {
+	return c.PutTimesheet(input.put(PutTimesheetBody{Status: StatusPending}))
+}
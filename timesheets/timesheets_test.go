@@ -0,0 +1,336 @@
+package timesheets_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/timesheets"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) timesheets.Client {
+	return timesheets.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetTimesheets(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheets.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.GetTimesheetsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.GetTimesheetsInput{WorkspaceId: workspaceId, Query: timesheets.GetTimesheetsQuery{Statuses: []timesheets.Status{timesheets.StatusSubmitted, timesheets.StatusApproved}}}, testFile, nil},
+		{"parameter error", successClient, timesheets.GetTimesheetsInput{Query: timesheets.GetTimesheetsQuery{Statuses: []timesheets.Status{timesheets.StatusSubmitted, timesheets.StatusApproved}}}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.GetTimesheetsInput{WorkspaceId: workspaceId, Query: timesheets.GetTimesheetsQuery{Statuses: []timesheets.Status{timesheets.StatusSubmitted, timesheets.StatusApproved}}}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetTimesheets(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutTimesheet(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(timesheets.PutTimesheetOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	timesheetSetupId := 1234567890
+	startDate := "2024-01-01"
+	body := timesheets.PutTimesheetBody{Status: timesheets.StatusSubmitted}
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.PutTimesheetInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.PutTimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate, Body: body}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, timesheets.PutTimesheetInput{TimesheetSetupId: timesheetSetupId, StartDate: startDate, Body: body}, errorWant, timesheets.ErrorRequiredParameter},
+		{"TimesheetSetupId parameter error", successClient, timesheets.PutTimesheetInput{WorkspaceId: workspaceId, StartDate: startDate, Body: body}, errorWant, timesheets.ErrorRequiredParameter},
+		{"StartDate parameter error", successClient, timesheets.PutTimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, Body: body}, errorWant, timesheets.ErrorRequiredParameter},
+		{"Status parameter error", successClient, timesheets.PutTimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.PutTimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate, Body: body}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutTimesheet(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestSubmitTimesheet(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(timesheets.PutTimesheetOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	timesheetSetupId := 1234567890
+	startDate := "2024-01-01"
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.TimesheetInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, timesheets.TimesheetInput{TimesheetSetupId: timesheetSetupId, StartDate: startDate}, errorWant, timesheets.ErrorRequiredParameter},
+		{"TimesheetSetupId parameter error", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, StartDate: startDate}, errorWant, timesheets.ErrorRequiredParameter},
+		{"StartDate parameter error", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.SubmitTimesheet(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestApproveTimesheet(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(timesheets.PutTimesheetOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	timesheetSetupId := 1234567890
+	startDate := "2024-01-01"
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.TimesheetInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, timesheets.TimesheetInput{TimesheetSetupId: timesheetSetupId, StartDate: startDate}, errorWant, timesheets.ErrorRequiredParameter},
+		{"TimesheetSetupId parameter error", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, StartDate: startDate}, errorWant, timesheets.ErrorRequiredParameter},
+		{"StartDate parameter error", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.ApproveTimesheet(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestRejectTimesheet(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(timesheets.PutTimesheetOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timesheetInput := timesheets.TimesheetInput{WorkspaceId: 123456789, TimesheetSetupId: 1234567890, StartDate: "2024-01-01"}
+	reason := "reason"
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.RejectTimesheetInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.RejectTimesheetInput{TimesheetInput: timesheetInput, Reason: reason}, testFile, nil},
+		{"TimesheetInput parameter error", successClient, timesheets.RejectTimesheetInput{Reason: reason}, errorWant, timesheets.ErrorRequiredParameter},
+		{"Reason parameter error", successClient, timesheets.RejectTimesheetInput{TimesheetInput: timesheetInput}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.RejectTimesheetInput{TimesheetInput: timesheetInput, Reason: reason}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.RejectTimesheet(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestReopenTimesheet(t *testing.T) {
+	testFile := readTestFile(t, "testdata/timesheets/timesheet.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(timesheets.PutTimesheetOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	timesheetSetupId := 1234567890
+	startDate := "2024-01-01"
+	test := []struct {
+		name     string
+		client   timesheets.Client
+		arg      timesheets.TimesheetInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, timesheets.TimesheetInput{TimesheetSetupId: timesheetSetupId, StartDate: startDate}, errorWant, timesheets.ErrorRequiredParameter},
+		{"TimesheetSetupId parameter error", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, StartDate: startDate}, errorWant, timesheets.ErrorRequiredParameter},
+		{"StartDate parameter error", successClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId}, errorWant, timesheets.ErrorRequiredParameter},
+		{"http error", errorClient, timesheets.TimesheetInput{WorkspaceId: workspaceId, TimesheetSetupId: timesheetSetupId, StartDate: startDate}, errorWant, timesheets.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.ReopenTimesheet(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestTimesheetStatusBody(t *testing.T) {
+	input := timesheets.TimesheetInput{WorkspaceId: 123456789, TimesheetSetupId: 1234567890, StartDate: "2024-01-01"}
+	test := []struct {
+		name string
+		call func(timesheets.Client) error
+		want timesheets.PutTimesheetBody
+	}{
+		{"submit", func(c timesheets.Client) error { _, err := c.SubmitTimesheet(input); return err }, timesheets.PutTimesheetBody{Status: timesheets.StatusSubmitted}},
+		{"approve", func(c timesheets.Client) error { _, err := c.ApproveTimesheet(input); return err }, timesheets.PutTimesheetBody{Status: timesheets.StatusApproved}},
+		{"reject", func(c timesheets.Client) error {
+			_, err := c.RejectTimesheet(timesheets.RejectTimesheetInput{TimesheetInput: input, Reason: "reason"})
+			return err
+		}, timesheets.PutTimesheetBody{Status: timesheets.StatusRejected, RejectionComment: "reason"}},
+		{"reopen", func(c timesheets.Client) error { _, err := c.ReopenTimesheet(input); return err }, timesheets.PutTimesheetBody{Status: timesheets.StatusPending}},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			var got timesheets.PutTimesheetBody
+			client := timesheets.Client{
+				HttpClient: MockHttpClient{
+					DoFunc: func(r *http.Request) (*http.Response, error) {
+						if r.Method != http.MethodPut || r.URL.Path != "/api/v9/workspaces/123456789/timesheets/1234567890/2024-01-01" {
+							t.Errorf("unexpected request: %v %v", r.Method, r.URL.Path)
+						}
+						if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+							t.Fatal(err)
+						}
+						return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString("{}"))}, nil
+					},
+				},
+			}
+			if err := tt.call(client); err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("diff: %v", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
+func TestPutTimesheetEscape(t *testing.T) {
+	var got string
+	client := timesheets.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				got = r.URL.EscapedPath()
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(`{}`))}, nil
+			},
+		},
+	}
+	if _, err := client.PutTimesheet(timesheets.PutTimesheetInput{WorkspaceId: 1, TimesheetSetupId: 2, StartDate: "2024/01 01", Body: timesheets.PutTimesheetBody{Status: timesheets.StatusSubmitted}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/api/v9/workspaces/1/timesheets/2/2024%2F01%2001"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -3,18 +3,51 @@
 package toggl
 
 import (
+	"github.com/dev-shimada/toggl-go/auditlogs"
+	"github.com/dev-shimada/toggl-go/favorites"
+	"github.com/dev-shimada/toggl-go/goals"
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/organizations"
+	"github.com/dev-shimada/toggl-go/rates"
+	"github.com/dev-shimada/toggl-go/reference"
+	"github.com/dev-shimada/toggl-go/reports"
 	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/dev-shimada/toggl-go/timesheets"
+	"github.com/dev-shimada/toggl-go/webhooks"
+	"github.com/dev-shimada/toggl-go/workspaces"
 )
 
-// Client represents a Toggl client with access to time entries.
+// Client represents a Toggl client with access to time entries and other Toggl resources.
 type Client struct {
-	TimeEntriesClient timeentries.Client
+	TimeEntriesClient   timeentries.Client
+	MeClient            me.Client
+	OrganizationsClient organizations.Client
+	WorkspacesClient    workspaces.Client
+	ReportsClient       reports.Client
+	WebhooksClient      webhooks.Client
+	GoalsClient         goals.Client
+	FavoritesClient     favorites.Client
+	TimesheetsClient    timesheets.Client
+	RatesClient         rates.Client
+	AuditLogsClient     auditlogs.Client
+	ReferenceClient     reference.Client
 }
 
 // NewClient creates a new Toggl client with the provided API token.
-// It returns a Client struct with a TimeEntriesClient initialized.
+// It returns a Client struct with every resource client initialized.
 func NewClient(token string) Client {
 	return Client{
-		TimeEntriesClient: timeentries.NewClient(token),
+		TimeEntriesClient:   timeentries.NewClient(token),
+		MeClient:            me.NewClient(token),
+		OrganizationsClient: organizations.NewClient(token),
+		WorkspacesClient:    workspaces.NewClient(token),
+		ReportsClient:       reports.NewClient(token),
+		WebhooksClient:      webhooks.NewClient(token),
+		GoalsClient:         goals.NewClient(token),
+		FavoritesClient:     favorites.NewClient(token),
+		TimesheetsClient:    timesheets.NewClient(token),
+		RatesClient:         rates.NewClient(token),
+		AuditLogsClient:     auditlogs.NewClient(token),
+		ReferenceClient:     reference.NewClient(token),
 	}
 }
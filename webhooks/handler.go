@@ -0,0 +1,336 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/timeentries"
+)
+
+const (
+	// SignatureHeader is the header carrying the HMAC-SHA256 signature of the event body.
+	SignatureHeader = "X-Webhook-Signature-256"
+	// DefaultTolerance is the default maximum age of an accepted event. It is wide
+	// enough for the retries of Toggl after hours of downtime of the receiver.
+	DefaultTolerance = 24 * time.Hour
+
+	maxEventSize = 1 << 20
+	// failedRetention is how long failed events are remembered to accept their redelivery.
+	failedRetention = 24 * time.Hour
+)
+
+// Metadata describes the entity and action an event is about.
+type Metadata struct {
+	Action      Action `json:"action"`
+	Model       Entity `json:"model"`
+	Path        string `json:"path"`
+	RequestType string `json:"request_type"`
+}
+
+// Event represents an event delivered to a webhook subscription.
+type Event struct {
+	CreatedAt         string          `json:"created_at"`
+	CreatorId         int             `json:"creator_id"`
+	EventId           int             `json:"event_id"`
+	Metadata          Metadata        `json:"metadata"`
+	Payload           json.RawMessage `json:"payload"`
+	SubscriptionId    int             `json:"subscription_id"`
+	Timestamp         string          `json:"timestamp"`
+	UrlCallback       string          `json:"url_callback"`
+	ValidationCode    string          `json:"validation_code,omitempty"`
+	ValidationCodeUrl string          `json:"validation_code_url,omitempty"`
+}
+
+// IsPing reports whether the event is a ping or a URL validation request.
+func (e Event) IsPing() bool {
+	return string(e.Payload) == `"ping"`
+}
+
+// Handler is an http.Handler receiving the events of a webhook subscription.
+// It verifies the signature of every event, answers validation pings, drops
+// replayed events and dispatches the decoded payloads to the registered callbacks.
+//
+// Replays are recognized by the signed event ID, remembered for twice the tolerance.
+// An event not seen before is rejected if it is older than the tolerance, except the
+// redelivery of an event whose callbacks failed, which is accepted at any age within
+// a day. A negative tolerance accepts events of any age, and then replays are only
+// recognized within twice DefaultTolerance.
+type Handler struct {
+	Secret    string        // Secret of the subscription
+	Tolerance time.Duration // Maximum age of a new event, DefaultTolerance if zero, none if negative
+
+	mu          sync.Mutex
+	seen        map[int]time.Time // Delivered events by ID
+	failed      map[int]time.Time // Events whose callbacks failed by ID, awaiting redelivery
+	onTimeEntry []func(Event, timeentries.GetTimeEntriesOutput) error
+	onProject   []func(Event, me.GetProjectsOutput) error
+	onClient    []func(Event, me.GetClientsOutput) error
+	onTag       []func(Event, me.GetTagsOutput) error
+	onTask      []func(Event, me.GetTasksOutput) error
+	onEvent     []func(Event) error
+}
+
+// NewHandler creates a new Handler verifying events with the given subscription secret.
+func NewHandler(secret string) *Handler {
+	return &Handler{
+		Secret:    secret,
+		Tolerance: DefaultTolerance,
+		seen:      map[int]time.Time{},
+		failed:    map[int]time.Time{},
+	}
+}
+
+// OnTimeEntry registers a callback for time entry events.
+func (h *Handler) OnTimeEntry(f func(Event, timeentries.GetTimeEntriesOutput) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onTimeEntry = append(h.onTimeEntry, f)
+}
+
+// OnProject registers a callback for project events.
+func (h *Handler) OnProject(f func(Event, me.GetProjectsOutput) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onProject = append(h.onProject, f)
+}
+
+// OnClient registers a callback for client events.
+func (h *Handler) OnClient(f func(Event, me.GetClientsOutput) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onClient = append(h.onClient, f)
+}
+
+// OnTag registers a callback for tag events.
+func (h *Handler) OnTag(f func(Event, me.GetTagsOutput) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onTag = append(h.onTag, f)
+}
+
+// OnTask registers a callback for task events.
+func (h *Handler) OnTask(f func(Event, me.GetTasksOutput) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onTask = append(h.onTask, f)
+}
+
+// OnEvent registers a callback receiving every event, including entities without a typed callback.
+func (h *Handler) OnEvent(f func(Event) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onEvent = append(h.onEvent, f)
+}
+
+// Sign returns the signature header value of body for the given secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is a valid signature of body for the given secret.
+func Verify(secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxEventSize))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if !Verify(h.Secret, body, r.Header.Get(SignatureHeader)) {
+		slog.Error("Invalid webhook signature")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	event := Event{}
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if event.ValidationCode != "" {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]string{"validation_code": event.ValidationCode}); err != nil {
+			slog.Error(fmt.Sprintf("Failed to answer validation: %v", err))
+		}
+		return
+	}
+	if event.IsPing() {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	delivered, err := h.claim(event)
+	if err != nil {
+		slog.Error(err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if delivered {
+		// Already delivered, acknowledge so that it is not sent again.
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := h.dispatch(event); err != nil {
+		// Remember the failure so that the redelivery is processed, however late.
+		h.fail(event.EventId)
+		slog.Error(fmt.Sprintf("Failed to handle webhook event %d: %v", event.EventId, err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// claim records the event as being handled and reports whether it was already delivered.
+// It rejects events older than the tolerance, if any, unless they are the redelivery of a failed event.
+func (h *Handler) claim(event Event) (bool, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.seen == nil {
+		h.seen = map[int]time.Time{}
+	}
+	if h.failed == nil {
+		h.failed = map[int]time.Time{}
+	}
+	now := time.Now()
+	// Older delivered events are rejected by their age, so their IDs need not be kept.
+	for id, at := range h.seen {
+		if now.Sub(at) > h.seenRetention() {
+			delete(h.seen, id)
+		}
+	}
+	for id, at := range h.failed {
+		if now.Sub(at) > failedRetention {
+			delete(h.failed, id)
+		}
+	}
+
+	if event.EventId != 0 {
+		if _, ok := h.seen[event.EventId]; ok {
+			return true, nil
+		}
+		if _, ok := h.failed[event.EventId]; ok {
+			delete(h.failed, event.EventId)
+			h.seen[event.EventId] = now
+			return false, nil
+		}
+	}
+	if event.Timestamp != "" {
+		ts, err := time.Parse(time.RFC3339Nano, event.Timestamp)
+		if err != nil {
+			return false, fmt.Errorf("invalid webhook event timestamp: %v", event.Timestamp)
+		}
+		if h.tolerance() > 0 && now.Sub(ts) > h.tolerance() {
+			return false, fmt.Errorf("webhook event %d is too old: %v", event.EventId, event.Timestamp)
+		}
+	}
+	if event.EventId != 0 {
+		h.seen[event.EventId] = now
+	}
+	return false, nil
+}
+
+// fail forgets the delivery of the event and accepts its redelivery.
+func (h *Handler) fail(eventId int) {
+	if eventId == 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.seen, eventId)
+	if h.failed == nil {
+		h.failed = map[int]time.Time{}
+	}
+	h.failed[eventId] = time.Now()
+}
+
+func (h *Handler) tolerance() time.Duration {
+	if h.Tolerance == 0 {
+		return DefaultTolerance
+	}
+	return h.Tolerance
+}
+
+// seenRetention is how long the IDs of delivered events are kept to recognize replays.
+func (h *Handler) seenRetention() time.Duration {
+	if h.tolerance() < 0 {
+		return 2 * DefaultTolerance
+	}
+	return 2 * h.tolerance()
+}
+
+// dispatch decodes the payload of the event according to its model and calls the registered callbacks.
+func (h *Handler) dispatch(event Event) error {
+	h.mu.Lock()
+	onTimeEntry, onProject, onClient, onTag, onTask, onEvent := h.onTimeEntry, h.onProject, h.onClient, h.onTag, h.onTask, h.onEvent
+	h.mu.Unlock()
+
+	switch event.Metadata.Model {
+	case EntityTimeEntry:
+		if err := dispatchPayload(event, onTimeEntry); err != nil {
+			return err
+		}
+	case EntityProject:
+		if err := dispatchPayload(event, onProject); err != nil {
+			return err
+		}
+	case EntityClient:
+		if err := dispatchPayload(event, onClient); err != nil {
+			return err
+		}
+	case EntityTag:
+		if err := dispatchPayload(event, onTag); err != nil {
+			return err
+		}
+	case EntityTask:
+		if err := dispatchPayload(event, onTask); err != nil {
+			return err
+		}
+	}
+	for _, f := range onEvent {
+		if err := f(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dispatchPayload[T any](event Event, callbacks []func(Event, T) error) error {
+	if len(callbacks) == 0 {
+		return nil
+	}
+	var payload T
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return err
+	}
+	for _, f := range callbacks {
+		if err := f(event, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
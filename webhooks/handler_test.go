@@ -0,0 +1,222 @@
+package webhooks_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dev-shimada/toggl-go/me"
+	"github.com/dev-shimada/toggl-go/timeentries"
+	"github.com/dev-shimada/toggl-go/webhooks"
+)
+
+func deliver(h http.Handler, secret string, body []byte) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	r.Header.Set(webhooks.SignatureHeader, webhooks.Sign(secret, body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func eventBody(eventId int, model webhooks.Entity, payload string, timestamp time.Time) []byte {
+	return []byte(fmt.Sprintf(
+		`{"event_id":%d,"metadata":{"action":"created","model":"%s"},"payload":%s,"subscription_id":1,"timestamp":"%s"}`,
+		eventId, model, payload, timestamp.Format(time.RFC3339),
+	))
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"event_id":1}`)
+	test := []struct {
+		name      string
+		signature string
+		want      bool
+	}{
+		{"valid", webhooks.Sign("secret", body), true},
+		{"wrong secret", webhooks.Sign("other", body), false},
+		{"missing prefix", webhooks.Sign("secret", body)[len("sha256="):], false},
+		{"empty", "", false},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := webhooks.Verify("secret", body, tt.signature); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestHandlerSignature(t *testing.T) {
+	h := webhooks.NewHandler("secret")
+	body := eventBody(1, webhooks.EntityTimeEntry, `{"id":1}`, time.Now())
+
+	if w := deliver(h, "other", body); w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
+
+func TestHandlerValidation(t *testing.T) {
+	h := webhooks.NewHandler("secret")
+	body := []byte(`{"event_id":1,"payload":"ping","validation_code":"code"}`)
+
+	w := deliver(h, "secret", body)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected %d, got %d", http.StatusOK, w.Code)
+	}
+	got := map[string]string{}
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got["validation_code"] != "code" {
+		t.Errorf("Expected validation code to be echoed, got %v", got)
+	}
+}
+
+func TestHandlerDispatch(t *testing.T) {
+	h := webhooks.NewHandler("secret")
+	var timeEntry timeentries.GetTimeEntriesOutput
+	var project me.GetProjectsOutput
+	events := 0
+	h.OnTimeEntry(func(e webhooks.Event, te timeentries.GetTimeEntriesOutput) error {
+		timeEntry = te
+		return nil
+	})
+	h.OnProject(func(e webhooks.Event, p me.GetProjectsOutput) error {
+		project = p
+		return nil
+	})
+	h.OnEvent(func(e webhooks.Event) error {
+		events++
+		return nil
+	})
+
+	if w := deliver(h, "secret", eventBody(1, webhooks.EntityTimeEntry, `{"id":10,"description":"work"}`, time.Now())); w.Code != http.StatusOK {
+		t.Fatalf("Expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if w := deliver(h, "secret", eventBody(2, webhooks.EntityProject, `{"id":20,"name":"project"}`, time.Now())); w.Code != http.StatusOK {
+		t.Fatalf("Expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if timeEntry.Id != 10 || timeEntry.Description != "work" {
+		t.Errorf("unexpected time entry: %+v", timeEntry)
+	}
+	if project.Id != 20 || project.Name != "project" {
+		t.Errorf("unexpected project: %+v", project)
+	}
+	if events != 2 {
+		t.Errorf("Expected 2 events, got %d", events)
+	}
+}
+
+func TestHandlerReplay(t *testing.T) {
+	h := webhooks.NewHandler("secret")
+	calls := 0
+	h.OnEvent(func(e webhooks.Event) error {
+		calls++
+		return nil
+	})
+
+	body := eventBody(1, webhooks.EntityTag, `{"id":1}`, time.Now())
+	deliver(h, "secret", body)
+	if w := deliver(h, "secret", body); w.Code != http.StatusOK {
+		t.Errorf("Expected duplicate to be acknowledged, got %d", w.Code)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+
+	// Retries after hours of downtime are accepted, older events are not.
+	late := eventBody(2, webhooks.EntityTag, `{"id":1}`, time.Now().Add(-6*time.Hour))
+	if w := deliver(h, "secret", late); w.Code != http.StatusOK {
+		t.Errorf("Expected %d, got %d", http.StatusOK, w.Code)
+	}
+	old := eventBody(3, webhooks.EntityTag, `{"id":1}`, time.Now().Add(-25*time.Hour))
+	if w := deliver(h, "secret", old); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+
+	// A negative tolerance accepts events of any age, but still drops replays.
+	h.Tolerance = -1
+	if w := deliver(h, "secret", old); w.Code != http.StatusOK {
+		t.Errorf("Expected %d, got %d", http.StatusOK, w.Code)
+	}
+	deliver(h, "secret", old)
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestHandlerCallbackError(t *testing.T) {
+	h := webhooks.NewHandler("secret")
+	fail := true
+	calls := 0
+	h.OnTask(func(e webhooks.Event, task me.GetTasksOutput) error {
+		calls++
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	body := eventBody(1, webhooks.EntityTask, `{"id":1}`, time.Now())
+	if w := deliver(h, "secret", body); w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+	fail = false
+	if w := deliver(h, "secret", body); w.Code != http.StatusOK {
+		t.Errorf("Expected redelivery to succeed, got %d", w.Code)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
+
+func TestHandlerLateRedelivery(t *testing.T) {
+	h := webhooks.NewHandler("secret")
+	fail := true
+	calls := 0
+	h.OnEvent(func(e webhooks.Event) error {
+		calls++
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	body := eventBody(1, webhooks.EntityTag, `{"id":1}`, time.Now().Add(-time.Minute))
+	if w := deliver(h, "secret", body); w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+	// The redelivery comes after the tolerance.
+	h.Tolerance = time.Second
+	fail = false
+	if w := deliver(h, "secret", body); w.Code != http.StatusOK {
+		t.Errorf("Expected late redelivery to succeed, got %d", w.Code)
+	}
+	if w := deliver(h, "secret", body); w.Code != http.StatusOK {
+		t.Errorf("Expected duplicate to be acknowledged, got %d", w.Code)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+
+	// An old event never seen before is still rejected.
+	old := eventBody(2, webhooks.EntityTag, `{"id":1}`, time.Now().Add(-time.Minute))
+	if w := deliver(h, "secret", old); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
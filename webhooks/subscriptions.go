@@ -0,0 +1,441 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	subscriptionsPath = "/webhooks/api/v1/subscriptions/%d"
+	subscriptionPath  = "/webhooks/api/v1/subscriptions/%d/%d"
+	pingPath          = "/webhooks/api/v1/ping/%d/%d"
+	validatePath      = "/webhooks/api/v1/validate/%d/%d/%s"
+	eventFiltersPath  = "/webhooks/api/v1/event_filters"
+)
+
+// Entity represents the kind of object an event is about.
+type Entity string
+
+const (
+	EntityAll         Entity = "*"
+	EntityClient      Entity = "client"
+	EntityProject     Entity = "project"
+	EntityTag         Entity = "tag"
+	EntityTask        Entity = "task"
+	EntityTimeEntry   Entity = "time_entry"
+	EntityWorkspace   Entity = "workspace"
+	EntityProjectUser Entity = "project_user"
+)
+
+// Action represents what happened to the entity of an event.
+type Action string
+
+const (
+	ActionAll     Action = "*"
+	ActionCreated Action = "created"
+	ActionUpdated Action = "updated"
+	ActionDeleted Action = "deleted"
+)
+
+// EventFilter represents an entity and action a subscription is notified about.
+type EventFilter struct {
+	Entity Entity `json:"entity"`
+	Action Action `json:"action"`
+}
+
+// GetSubscriptionsInput contains the input data for GetSubscriptions.
+type GetSubscriptionsInput struct {
+	WorkspaceId int // required
+}
+
+// GetSubscriptionsOutput represents a webhook subscription.
+type GetSubscriptionsOutput struct {
+	CreatedAt        string        `json:"created_at"`
+	DeletedAt        *string       `json:"deleted_at"`
+	Description      string        `json:"description"`
+	Enabled          bool          `json:"enabled"`
+	EventFilters     []EventFilter `json:"event_filters"`
+	HasPendingEvents bool          `json:"has_pending_events"`
+	Secret           string        `json:"secret"`
+	SubscriptionId   int           `json:"subscription_id"`
+	UpdatedAt        string        `json:"updated_at"`
+	UrlCallback      string        `json:"url_callback"`
+	UserId           int           `json:"user_id"`
+	ValidatedAt      *string       `json:"validated_at"`
+	WorkspaceId      int           `json:"workspace_id"`
+}
+
+// GetSubscriptions retrieves the webhook subscriptions of a workspace.
+func (c Client) GetSubscriptions(input GetSubscriptionsInput) ([]GetSubscriptionsOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(subscriptionsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetSubscriptionsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gso := make([]GetSubscriptionsOutput, 0)
+	if err := json.Unmarshal(body, &gso); err != nil {
+		return nil, err
+	}
+
+	return gso, nil
+}
+
+// PostSubscriptionBody represents the body of the request to create a webhook subscription.
+type PostSubscriptionBody struct {
+	Description  string        `json:"description"`      // Description of the subscription, required, unique in the workspace
+	Enabled      bool          `json:"enabled"`          // Whether events are delivered to the subscription
+	EventFilters []EventFilter `json:"event_filters"`    // Entities and actions to be notified about, required
+	Secret       string        `json:"secret,omitempty"` // Secret used to sign the events, generated by Toggl if omitted
+	UrlCallback  string        `json:"url_callback"`     // URL the events are delivered to, required
+}
+
+// PostSubscriptionInput contains the input data for PostSubscription.
+type PostSubscriptionInput struct {
+	WorkspaceId int // required
+	Body        PostSubscriptionBody
+}
+
+// PostSubscriptionOutput represents the response after creating a webhook subscription.
+type PostSubscriptionOutput = GetSubscriptionsOutput
+
+// PostSubscription creates a new webhook subscription in a workspace.
+func (c Client) PostSubscription(input PostSubscriptionInput) (PostSubscriptionOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostSubscriptionOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostSubscriptionOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(subscriptionsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostSubscriptionOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostSubscriptionOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostSubscriptionOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostSubscriptionOutput{}, ErrorStatusNotOK
+	}
+
+	pso := PostSubscriptionOutput{}
+	if err := json.Unmarshal(body, &pso); err != nil {
+		return PostSubscriptionOutput{}, err
+	}
+
+	return pso, nil
+}
+
+// PutSubscriptionBody represents the body of the request to update a webhook subscription.
+type PutSubscriptionBody = PostSubscriptionBody
+
+// PutSubscriptionInput contains the input data for PutSubscription.
+type PutSubscriptionInput struct {
+	WorkspaceId    int // required
+	SubscriptionId int // required
+	Body           PutSubscriptionBody
+}
+
+// PutSubscriptionOutput represents the response after updating a webhook subscription.
+type PutSubscriptionOutput = GetSubscriptionsOutput
+
+// PutSubscription updates an existing webhook subscription.
+func (c Client) PutSubscription(input PutSubscriptionInput) (PutSubscriptionOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutSubscriptionOutput{}, ErrorRequiredParameter
+	}
+	if input.SubscriptionId == 0 {
+		slog.Error("SubscriptionId is required")
+		return PutSubscriptionOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutSubscriptionOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(subscriptionPath, input.WorkspaceId, input.SubscriptionId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutSubscriptionOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutSubscriptionOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutSubscriptionOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutSubscriptionOutput{}, ErrorStatusNotOK
+	}
+
+	pso := PutSubscriptionOutput{}
+	if err := json.Unmarshal(body, &pso); err != nil {
+		return PutSubscriptionOutput{}, err
+	}
+
+	return pso, nil
+}
+
+// PatchSubscriptionBody represents the body of the request to enable or disable a webhook subscription.
+type PatchSubscriptionBody struct {
+	Enabled bool `json:"enabled"` // Whether events are delivered to the subscription
+}
+
+// PatchSubscriptionInput contains the input data for PatchSubscription.
+type PatchSubscriptionInput struct {
+	WorkspaceId    int // required
+	SubscriptionId int // required
+	Body           PatchSubscriptionBody
+}
+
+// PatchSubscriptionOutput represents the response after enabling or disabling a webhook subscription.
+type PatchSubscriptionOutput = GetSubscriptionsOutput
+
+// PatchSubscription enables or disables a webhook subscription.
+func (c Client) PatchSubscription(input PatchSubscriptionInput) (PatchSubscriptionOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PatchSubscriptionOutput{}, ErrorRequiredParameter
+	}
+	if input.SubscriptionId == 0 {
+		slog.Error("SubscriptionId is required")
+		return PatchSubscriptionOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PatchSubscriptionOutput{}, err
+	}
+	toggl := c.Patch(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(subscriptionPath, input.WorkspaceId, input.SubscriptionId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PatchSubscriptionOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PatchSubscriptionOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PatchSubscriptionOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PatchSubscriptionOutput{}, ErrorStatusNotOK
+	}
+
+	pso := PatchSubscriptionOutput{}
+	if err := json.Unmarshal(body, &pso); err != nil {
+		return PatchSubscriptionOutput{}, err
+	}
+
+	return pso, nil
+}
+
+// DeleteSubscriptionInput contains the input data for DeleteSubscription.
+type DeleteSubscriptionInput struct {
+	WorkspaceId    int // required
+	SubscriptionId int // required
+}
+
+// DeleteSubscription deletes a webhook subscription.
+func (c Client) DeleteSubscription(input DeleteSubscriptionInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.SubscriptionId == 0 {
+		slog.Error("SubscriptionId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(subscriptionPath, input.WorkspaceId, input.SubscriptionId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// PostPingInput contains the input data for PostPing.
+type PostPingInput struct {
+	WorkspaceId    int // required
+	SubscriptionId int // required
+}
+
+// PostPing sends a ping event to the callback URL of a webhook subscription.
+func (c Client) PostPing(input PostPingInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.SubscriptionId == 0 {
+		slog.Error("SubscriptionId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Post(url.URL{}, nil)
+	toggl.URL.Path = fmt.Sprintf(pingPath, input.WorkspaceId, input.SubscriptionId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// GetValidateInput contains the input data for GetValidate.
+type GetValidateInput struct {
+	WorkspaceId    int    // required
+	SubscriptionId int    // required
+	ValidationCode string // required, the code received by the callback URL
+}
+
+// GetValidate validates the callback URL of a webhook subscription with the
+// validation code delivered to it, for endpoints that cannot echo the code back.
+func (c Client) GetValidate(input GetValidateInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.SubscriptionId == 0 {
+		slog.Error("SubscriptionId is required")
+		return ErrorRequiredParameter
+	}
+	if input.ValidationCode == "" {
+		slog.Error("ValidationCode is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(validatePath, input.WorkspaceId, input.SubscriptionId, input.ValidationCode)
+	toggl.URL.RawPath = fmt.Sprintf(validatePath, input.WorkspaceId, input.SubscriptionId, url.PathEscape(input.ValidationCode))
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// GetEventFiltersOutput represents the actions available for each entity.
+type GetEventFiltersOutput map[Entity][]Action
+
+// GetEventFilters retrieves the entities and actions a subscription can filter on.
+func (c Client) GetEventFilters() (GetEventFiltersOutput, error) {
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = eventFiltersPath
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return GetEventFiltersOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gefo := GetEventFiltersOutput{}
+	if err := json.Unmarshal(body, &gefo); err != nil {
+		return nil, err
+	}
+
+	return gefo, nil
+}
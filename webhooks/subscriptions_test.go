@@ -0,0 +1,321 @@
+package webhooks_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/webhooks"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) webhooks.Client {
+	return webhooks.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetSubscriptions(t *testing.T) {
+	testFile := readTestFile(t, "testdata/webhooks/subscriptions.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   webhooks.Client
+		arg      webhooks.GetSubscriptionsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, webhooks.GetSubscriptionsInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, webhooks.GetSubscriptionsInput{}, errorWant, webhooks.ErrorRequiredParameter},
+		{"http error", errorClient, webhooks.GetSubscriptionsInput{WorkspaceId: workspaceId}, errorWant, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetSubscriptions(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostSubscription(t *testing.T) {
+	testFile := readTestFile(t, "testdata/webhooks/subscription.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(webhooks.PostSubscriptionOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   webhooks.Client
+		arg      webhooks.PostSubscriptionInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, webhooks.PostSubscriptionInput{WorkspaceId: workspaceId, Body: webhooks.PostSubscriptionBody{Description: "description", EventFilters: []webhooks.EventFilter{{Entity: webhooks.EntityTimeEntry, Action: webhooks.ActionAll}}, UrlCallback: "https://example.com"}}, testFile, nil},
+		{"parameter error", successClient, webhooks.PostSubscriptionInput{Body: webhooks.PostSubscriptionBody{Description: "description", EventFilters: []webhooks.EventFilter{{Entity: webhooks.EntityTimeEntry, Action: webhooks.ActionAll}}, UrlCallback: "https://example.com"}}, errorWant, webhooks.ErrorRequiredParameter},
+		{"http error", errorClient, webhooks.PostSubscriptionInput{WorkspaceId: workspaceId, Body: webhooks.PostSubscriptionBody{Description: "description", EventFilters: []webhooks.EventFilter{{Entity: webhooks.EntityTimeEntry, Action: webhooks.ActionAll}}, UrlCallback: "https://example.com"}}, errorWant, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostSubscription(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutSubscription(t *testing.T) {
+	testFile := readTestFile(t, "testdata/webhooks/subscription.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(webhooks.PutSubscriptionOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	subscriptionId := 1234567890
+	test := []struct {
+		name     string
+		client   webhooks.Client
+		arg      webhooks.PutSubscriptionInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, webhooks.PutSubscriptionInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId, Body: webhooks.PutSubscriptionBody{Description: "description"}}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, webhooks.PutSubscriptionInput{SubscriptionId: subscriptionId, Body: webhooks.PutSubscriptionBody{Description: "description"}}, errorWant, webhooks.ErrorRequiredParameter},
+		{"SubscriptionId parameter error", successClient, webhooks.PutSubscriptionInput{WorkspaceId: workspaceId, Body: webhooks.PutSubscriptionBody{Description: "description"}}, errorWant, webhooks.ErrorRequiredParameter},
+		{"http error", errorClient, webhooks.PutSubscriptionInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId, Body: webhooks.PutSubscriptionBody{Description: "description"}}, errorWant, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutSubscription(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPatchSubscription(t *testing.T) {
+	testFile := readTestFile(t, "testdata/webhooks/subscription.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(webhooks.PatchSubscriptionOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	subscriptionId := 1234567890
+	test := []struct {
+		name     string
+		client   webhooks.Client
+		arg      webhooks.PatchSubscriptionInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, webhooks.PatchSubscriptionInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId, Body: webhooks.PatchSubscriptionBody{Enabled: true}}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, webhooks.PatchSubscriptionInput{SubscriptionId: subscriptionId, Body: webhooks.PatchSubscriptionBody{Enabled: true}}, errorWant, webhooks.ErrorRequiredParameter},
+		{"SubscriptionId parameter error", successClient, webhooks.PatchSubscriptionInput{WorkspaceId: workspaceId, Body: webhooks.PatchSubscriptionBody{Enabled: true}}, errorWant, webhooks.ErrorRequiredParameter},
+		{"http error", errorClient, webhooks.PatchSubscriptionInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId, Body: webhooks.PatchSubscriptionBody{Enabled: true}}, errorWant, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PatchSubscription(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	subscriptionId := 1234567890
+	test := []struct {
+		name    string
+		client  webhooks.Client
+		arg     webhooks.DeleteSubscriptionInput
+		wantErr error
+	}{
+		{"success", successClient, webhooks.DeleteSubscriptionInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId}, nil},
+		{"WorkspaceId parameter error", successClient, webhooks.DeleteSubscriptionInput{SubscriptionId: subscriptionId}, webhooks.ErrorRequiredParameter},
+		{"SubscriptionId parameter error", successClient, webhooks.DeleteSubscriptionInput{WorkspaceId: workspaceId}, webhooks.ErrorRequiredParameter},
+		{"http error", errorClient, webhooks.DeleteSubscriptionInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId}, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteSubscription(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestPostPing(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	subscriptionId := 1234567890
+	test := []struct {
+		name    string
+		client  webhooks.Client
+		arg     webhooks.PostPingInput
+		wantErr error
+	}{
+		{"success", successClient, webhooks.PostPingInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId}, nil},
+		{"WorkspaceId parameter error", successClient, webhooks.PostPingInput{SubscriptionId: subscriptionId}, webhooks.ErrorRequiredParameter},
+		{"SubscriptionId parameter error", successClient, webhooks.PostPingInput{WorkspaceId: workspaceId}, webhooks.ErrorRequiredParameter},
+		{"http error", errorClient, webhooks.PostPingInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId}, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.PostPing(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetValidate(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	subscriptionId := 1234567890
+	validationCode := "code"
+	test := []struct {
+		name    string
+		client  webhooks.Client
+		arg     webhooks.GetValidateInput
+		wantErr error
+	}{
+		{"success", successClient, webhooks.GetValidateInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId, ValidationCode: validationCode}, nil},
+		{"WorkspaceId parameter error", successClient, webhooks.GetValidateInput{SubscriptionId: subscriptionId, ValidationCode: validationCode}, webhooks.ErrorRequiredParameter},
+		{"SubscriptionId parameter error", successClient, webhooks.GetValidateInput{WorkspaceId: workspaceId, ValidationCode: validationCode}, webhooks.ErrorRequiredParameter},
+		{"ValidationCode parameter error", successClient, webhooks.GetValidateInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId}, webhooks.ErrorRequiredParameter},
+		{"http error", errorClient, webhooks.GetValidateInput{WorkspaceId: workspaceId, SubscriptionId: subscriptionId, ValidationCode: validationCode}, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.GetValidate(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetEventFilters(t *testing.T) {
+	testFile := readTestFile(t, "testdata/webhooks/event_filters.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := []struct {
+		name     string
+		client   webhooks.Client
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, testFile, nil},
+		{"http error", errorClient, errorWant, webhooks.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetEventFilters()
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestGetValidateEscape(t *testing.T) {
+	var got string
+	client := webhooks.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				got = r.URL.EscapedPath()
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(`{}`))}, nil
+			},
+		},
+	}
+	if err := client.GetValidate(webhooks.GetValidateInput{WorkspaceId: 1, SubscriptionId: 2, ValidationCode: "ab/c d?"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/webhooks/api/v1/validate/1/2/ab%2Fc%20d%3F"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
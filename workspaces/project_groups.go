@@ -0,0 +1,179 @@
+package workspaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	projectGroupsPath = "/api/v9/workspaces/%d/project_groups"
+	projectGroupPath  = "/api/v9/workspaces/%d/project_groups/%d"
+)
+
+// GetProjectGroupsQuery represents the query parameters for fetching project groups.
+type GetProjectGroupsQuery struct {
+	ProjectIds *string // Numeric project IDs separated by comma
+}
+
+// GetProjectGroupsInput contains the input data for GetProjectGroups.
+type GetProjectGroupsInput struct {
+	WorkspaceId int // required
+	Query       GetProjectGroupsQuery
+}
+
+// GetProjectGroupsOutput represents the access of a group to a project.
+type GetProjectGroupsOutput struct {
+	At          string `json:"at"`
+	GroupId     int    `json:"group_id"`
+	Id          int    `json:"id"`
+	ProjectId   int    `json:"project_id"`
+	WorkspaceId int    `json:"workspace_id"`
+}
+
+// GetProjectGroups retrieves the groups having access to the projects of a workspace.
+func (c Client) GetProjectGroups(input GetProjectGroupsInput) ([]GetProjectGroupsOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	q := url.Values{}
+	if input.Query.ProjectIds != nil {
+		q.Add("project_ids", *input.Query.ProjectIds)
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(projectGroupsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetProjectGroupsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gpgo := make([]GetProjectGroupsOutput, 0)
+	if err := json.Unmarshal(body, &gpgo); err != nil {
+		return nil, err
+	}
+
+	return gpgo, nil
+}
+
+// PostProjectGroupBody represents the body of the request to add a group to a project.
+type PostProjectGroupBody struct {
+	GroupId   int `json:"group_id"`   // Group ID, required
+	ProjectId int `json:"project_id"` // Project ID, required
+}
+
+// PostProjectGroupInput contains the input data for PostProjectGroup.
+type PostProjectGroupInput struct {
+	WorkspaceId int // required
+	Body        PostProjectGroupBody
+}
+
+// PostProjectGroupOutput represents the response after adding a group to a project.
+type PostProjectGroupOutput = GetProjectGroupsOutput
+
+// PostProjectGroup gives the members of a group access to a project.
+func (c Client) PostProjectGroup(input PostProjectGroupInput) (PostProjectGroupOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostProjectGroupOutput{}, ErrorRequiredParameter
+	}
+	if input.Body.GroupId == 0 {
+		slog.Error("GroupId is required")
+		return PostProjectGroupOutput{}, ErrorRequiredParameter
+	}
+	if input.Body.ProjectId == 0 {
+		slog.Error("ProjectId is required")
+		return PostProjectGroupOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostProjectGroupOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(projectGroupsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostProjectGroupOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostProjectGroupOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostProjectGroupOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostProjectGroupOutput{}, ErrorStatusNotOK
+	}
+
+	ppgo := PostProjectGroupOutput{}
+	if err := json.Unmarshal(body, &ppgo); err != nil {
+		return PostProjectGroupOutput{}, err
+	}
+
+	return ppgo, nil
+}
+
+// DeleteProjectGroupInput contains the input data for DeleteProjectGroup.
+type DeleteProjectGroupInput struct {
+	WorkspaceId    int // required
+	ProjectGroupId int // required
+}
+
+// DeleteProjectGroup removes the access of a group to a project.
+func (c Client) DeleteProjectGroup(input DeleteProjectGroupInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.ProjectGroupId == 0 {
+		slog.Error("ProjectGroupId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(projectGroupPath, input.WorkspaceId, input.ProjectGroupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package workspaces_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/workspaces"
+)
+
+func TestGetProjectGroups(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/project_groups.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.GetProjectGroupsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.GetProjectGroupsInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, workspaces.GetProjectGroupsInput{}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.GetProjectGroupsInput{WorkspaceId: workspaceId}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetProjectGroups(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostProjectGroup(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/project_group.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PostProjectGroupOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	body := workspaces.PostProjectGroupBody{GroupId: 1234567890, ProjectId: 1234567890}
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PostProjectGroupInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PostProjectGroupInput{WorkspaceId: workspaceId, Body: body}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.PostProjectGroupInput{Body: body}, errorWant, workspaces.ErrorRequiredParameter},
+		{"GroupId and ProjectId parameter error", successClient, workspaces.PostProjectGroupInput{WorkspaceId: workspaceId}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PostProjectGroupInput{WorkspaceId: workspaceId, Body: body}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostProjectGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteProjectGroup(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	projectGroupId := 1234567890
+	test := []struct {
+		name    string
+		client  workspaces.Client
+		arg     workspaces.DeleteProjectGroupInput
+		wantErr error
+	}{
+		{"success", successClient, workspaces.DeleteProjectGroupInput{WorkspaceId: workspaceId, ProjectGroupId: projectGroupId}, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.DeleteProjectGroupInput{ProjectGroupId: projectGroupId}, workspaces.ErrorRequiredParameter},
+		{"ProjectGroupId parameter error", successClient, workspaces.DeleteProjectGroupInput{WorkspaceId: workspaceId}, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.DeleteProjectGroupInput{WorkspaceId: workspaceId, ProjectGroupId: projectGroupId}, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteProjectGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
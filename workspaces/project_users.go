@@ -0,0 +1,343 @@
+package workspaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	projectUsersPath = "/api/v9/workspaces/%d/project_users"
+	projectUserPath  = "/api/v9/workspaces/%d/project_users/%d"
+	projectUsersBulk = "/api/v9/workspaces/%d/project_users/%s"
+)
+
+// GetProjectUsersQuery represents the query parameters for fetching project users.
+type GetProjectUsersQuery struct {
+	ProjectIds       *string // Numeric project IDs separated by comma
+	UserId           *int    // Project users of this user
+	WithGroupMembers bool    // Include users with access through a group
+}
+
+// GetProjectUsersInput contains the input data for GetProjectUsers.
+type GetProjectUsersInput struct {
+	WorkspaceId int // required
+	Query       GetProjectUsersQuery
+}
+
+// GetProjectUsersOutput represents the access of a user to a project.
+type GetProjectUsersOutput struct {
+	At                   string   `json:"at"`
+	GroupId              *int     `json:"group_id"` // Group giving the access, only with WithGroupMembers
+	Id                   int      `json:"id"`
+	LaborCost            *float64 `json:"labor_cost"`
+	LaborCostLastUpdated *string  `json:"labor_cost_last_updated"`
+	Manager              bool     `json:"manager"`
+	ProjectId            int      `json:"project_id"`
+	Rate                 *float64 `json:"rate"`
+	RateLastUpdated      *string  `json:"rate_last_updated"`
+	UserId               int      `json:"user_id"`
+	WorkspaceId          int      `json:"workspace_id"`
+}
+
+// GetProjectUsers retrieves the users having access to the projects of a workspace.
+func (c Client) GetProjectUsers(input GetProjectUsersInput) ([]GetProjectUsersOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	gpuq := input.Query
+	q := url.Values{}
+	if gpuq.ProjectIds != nil {
+		q.Add("project_ids", *gpuq.ProjectIds)
+	}
+	if gpuq.UserId != nil {
+		q.Add("user_id", fmt.Sprintf("%v", *gpuq.UserId))
+	}
+	if gpuq.WithGroupMembers {
+		q.Add("with_group_members", fmt.Sprintf("%v", gpuq.WithGroupMembers))
+	}
+	toggl := c.Get(url.URL{RawQuery: q.Encode()})
+	toggl.URL.Path = fmt.Sprintf(projectUsersPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetProjectUsersOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gpuo := make([]GetProjectUsersOutput, 0)
+	if err := json.Unmarshal(body, &gpuo); err != nil {
+		return nil, err
+	}
+
+	return gpuo, nil
+}
+
+// PostProjectUserBody represents the body of the request to add a user to a project.
+type PostProjectUserBody struct {
+	LaborCost           *float64 `json:"labor_cost,omitempty"`             // Labor cost of the user in the project
+	LaborCostChangeMode string   `json:"labor_cost_change_mode,omitempty"` // Labor cost change mode: "start-today", "override-current" or "override-all"
+	Manager             bool     `json:"manager,omitempty"`                // Whether the user manages the project
+	ProjectId           int      `json:"project_id"`                       // Project ID, required
+	Rate                *float64 `json:"rate,omitempty"`                   // Hourly rate of the user in the project
+	RateChangeMode      string   `json:"rate_change_mode,omitempty"`       // Rate change mode: "start-today", "override-current" or "override-all"
+	UserId              int      `json:"user_id"`                          // User ID, required
+}
+
+// PostProjectUserInput contains the input data for PostProjectUser.
+type PostProjectUserInput struct {
+	WorkspaceId int // required
+	Body        PostProjectUserBody
+}
+
+// PostProjectUserOutput represents the response after adding a user to a project.
+type PostProjectUserOutput = GetProjectUsersOutput
+
+// PostProjectUser gives a user access to a project.
+func (c Client) PostProjectUser(input PostProjectUserInput) (PostProjectUserOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostProjectUserOutput{}, ErrorRequiredParameter
+	}
+	if input.Body.ProjectId == 0 {
+		slog.Error("ProjectId is required")
+		return PostProjectUserOutput{}, ErrorRequiredParameter
+	}
+	if input.Body.UserId == 0 {
+		slog.Error("UserId is required")
+		return PostProjectUserOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostProjectUserOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(projectUsersPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostProjectUserOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostProjectUserOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostProjectUserOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostProjectUserOutput{}, ErrorStatusNotOK
+	}
+
+	ppuo := PostProjectUserOutput{}
+	if err := json.Unmarshal(body, &ppuo); err != nil {
+		return PostProjectUserOutput{}, err
+	}
+
+	return ppuo, nil
+}
+
+// PutProjectUserBody represents the body of the request to update a project user.
+type PutProjectUserBody struct {
+	LaborCost           *float64 `json:"labor_cost,omitempty"`             // Labor cost of the user in the project
+	LaborCostChangeMode string   `json:"labor_cost_change_mode,omitempty"` // Labor cost change mode: "start-today", "override-current" or "override-all"
+	Manager             *bool    `json:"manager,omitempty"`                // Whether the user manages the project
+	Rate                *float64 `json:"rate,omitempty"`                   // Hourly rate of the user in the project
+	RateChangeMode      string   `json:"rate_change_mode,omitempty"`       // Rate change mode: "start-today", "override-current" or "override-all"
+}
+
+// PutProjectUserInput contains the input data for PutProjectUser.
+type PutProjectUserInput struct {
+	WorkspaceId   int // required
+	ProjectUserId int // required
+	Body          PutProjectUserBody
+}
+
+// PutProjectUserOutput represents the response after updating a project user.
+type PutProjectUserOutput = GetProjectUsersOutput
+
+// PutProjectUser updates the rate, labor cost and manager flag of a project user.
+func (c Client) PutProjectUser(input PutProjectUserInput) (PutProjectUserOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutProjectUserOutput{}, ErrorRequiredParameter
+	}
+	if input.ProjectUserId == 0 {
+		slog.Error("ProjectUserId is required")
+		return PutProjectUserOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutProjectUserOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(projectUserPath, input.WorkspaceId, input.ProjectUserId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutProjectUserOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutProjectUserOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutProjectUserOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutProjectUserOutput{}, ErrorStatusNotOK
+	}
+
+	ppuo := PutProjectUserOutput{}
+	if err := json.Unmarshal(body, &ppuo); err != nil {
+		return PutProjectUserOutput{}, err
+	}
+
+	return ppuo, nil
+}
+
+// PatchProjectUsersInput contains the input data for PatchProjectUsers.
+// Only the non-nil fields are changed.
+type PatchProjectUsersInput struct {
+	WorkspaceId    int      // required
+	ProjectUserIds string   // required, numeric project user IDs separated by comma
+	Manager        *bool    // Whether the users manage their project
+	Rate           *float64 // Hourly rate of the users in their project
+	LaborCost      *float64 // Labor cost of the users in their project
+}
+
+// Failure represents a failure of a bulk operation.
+type Failure struct {
+	Id      int    `json:"id"`      // The ID for which the patch operation failed.
+	Message string `json:"message"` // The operation failure reason
+}
+
+// PatchProjectUsersOutput represents the response from bulk editing project users.
+type PatchProjectUsersOutput struct {
+	Failure []Failure `json:"failure"`
+	Success []int     `json:"success"` // The IDs for which the patch was successful.
+}
+
+// PatchProjectUsers changes the manager flag, rate or labor cost of several project users in a single request.
+func (c Client) PatchProjectUsers(input PatchProjectUsersInput) (PatchProjectUsersOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PatchProjectUsersOutput{}, ErrorRequiredParameter
+	}
+	if input.ProjectUserIds == "" {
+		slog.Error("ProjectUserIds is required")
+		return PatchProjectUsersOutput{}, ErrorRequiredParameter
+	}
+	ops := make([]patchOperation, 0, 3)
+	if input.Manager != nil {
+		ops = append(ops, patchOperation{Op: "replace", Path: "/manager", Value: *input.Manager})
+	}
+	if input.Rate != nil {
+		ops = append(ops, patchOperation{Op: "replace", Path: "/rate", Value: *input.Rate})
+	}
+	if input.LaborCost != nil {
+		ops = append(ops, patchOperation{Op: "replace", Path: "/labor_cost", Value: *input.LaborCost})
+	}
+	if len(ops) == 0 {
+		slog.Error("Manager, Rate or LaborCost is required")
+		return PatchProjectUsersOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(ops)
+	if err != nil {
+		return PatchProjectUsersOutput{}, err
+	}
+	toggl := c.Patch(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(projectUsersBulk, input.WorkspaceId, input.ProjectUserIds)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PatchProjectUsersOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PatchProjectUsersOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PatchProjectUsersOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PatchProjectUsersOutput{}, ErrorStatusNotOK
+	}
+
+	ppuo := PatchProjectUsersOutput{}
+	if err := json.Unmarshal(body, &ppuo); err != nil {
+		return PatchProjectUsersOutput{}, err
+	}
+
+	return ppuo, nil
+}
+
+// DeleteProjectUserInput contains the input data for DeleteProjectUser.
+type DeleteProjectUserInput struct {
+	WorkspaceId   int // required
+	ProjectUserId int // required
+}
+
+// DeleteProjectUser removes the access of a user to a project.
+func (c Client) DeleteProjectUser(input DeleteProjectUserInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.ProjectUserId == 0 {
+		slog.Error("ProjectUserId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(projectUserPath, input.WorkspaceId, input.ProjectUserId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package workspaces_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/workspaces"
+)
+
+func TestGetProjectUsers(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/project_users.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.GetProjectUsersInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.GetProjectUsersInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, workspaces.GetProjectUsersInput{}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.GetProjectUsersInput{WorkspaceId: workspaceId}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetProjectUsers(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostProjectUser(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/project_user.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PostProjectUserOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	rate := 100.0
+	body := workspaces.PostProjectUserBody{Manager: true, ProjectId: 1234567890, Rate: &rate, UserId: 1234567890}
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PostProjectUserInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PostProjectUserInput{WorkspaceId: workspaceId, Body: body}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.PostProjectUserInput{Body: body}, errorWant, workspaces.ErrorRequiredParameter},
+		{"ProjectId and UserId parameter error", successClient, workspaces.PostProjectUserInput{WorkspaceId: workspaceId}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PostProjectUserInput{WorkspaceId: workspaceId, Body: body}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostProjectUser(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutProjectUser(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/project_user.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PutProjectUserOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	projectUserId := 1234567890
+	manager := true
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PutProjectUserInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PutProjectUserInput{WorkspaceId: workspaceId, ProjectUserId: projectUserId, Body: workspaces.PutProjectUserBody{Manager: &manager}}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.PutProjectUserInput{ProjectUserId: projectUserId, Body: workspaces.PutProjectUserBody{Manager: &manager}}, errorWant, workspaces.ErrorRequiredParameter},
+		{"ProjectUserId parameter error", successClient, workspaces.PutProjectUserInput{WorkspaceId: workspaceId, Body: workspaces.PutProjectUserBody{Manager: &manager}}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PutProjectUserInput{WorkspaceId: workspaceId, ProjectUserId: projectUserId, Body: workspaces.PutProjectUserBody{Manager: &manager}}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutProjectUser(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPatchProjectUsers(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/patch_project_users.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PatchProjectUsersOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	projectUserIds := "1234567890,1234567891"
+	manager := true
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PatchProjectUsersInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PatchProjectUsersInput{WorkspaceId: workspaceId, ProjectUserIds: projectUserIds, Manager: &manager}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.PatchProjectUsersInput{ProjectUserIds: projectUserIds, Manager: &manager}, errorWant, workspaces.ErrorRequiredParameter},
+		{"ProjectUserIds parameter error", successClient, workspaces.PatchProjectUsersInput{WorkspaceId: workspaceId, Manager: &manager}, errorWant, workspaces.ErrorRequiredParameter},
+		{"Manager, Rate or LaborCost parameter error", successClient, workspaces.PatchProjectUsersInput{WorkspaceId: workspaceId, ProjectUserIds: projectUserIds}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PatchProjectUsersInput{WorkspaceId: workspaceId, ProjectUserIds: projectUserIds, Manager: &manager}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PatchProjectUsers(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteProjectUser(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	projectUserId := 1234567890
+	test := []struct {
+		name    string
+		client  workspaces.Client
+		arg     workspaces.DeleteProjectUserInput
+		wantErr error
+	}{
+		{"success", successClient, workspaces.DeleteProjectUserInput{WorkspaceId: workspaceId, ProjectUserId: projectUserId}, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.DeleteProjectUserInput{ProjectUserId: projectUserId}, workspaces.ErrorRequiredParameter},
+		{"ProjectUserId parameter error", successClient, workspaces.DeleteProjectUserInput{WorkspaceId: workspaceId}, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.DeleteProjectUserInput{WorkspaceId: workspaceId, ProjectUserId: projectUserId}, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteProjectUser(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,512 @@
+package workspaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	usersPath          = "/api/v9/workspaces/%d/users"
+	workspaceUsersPath = "/api/v9/workspaces/%d/workspace_users"
+	workspaceUserPath  = "/api/v9/workspaces/%d/workspace_users/%d"
+	groupsPath         = "/api/v9/workspaces/%d/groups"
+	groupPath          = "/api/v9/workspaces/%d/groups/%d"
+)
+
+// GetUsersInput contains the input data for GetUsers.
+type GetUsersInput struct {
+	WorkspaceId int // required
+}
+
+// GetUsersOutput represents a user of a workspace.
+type GetUsersOutput struct {
+	Email    string `json:"email"`
+	Fullname string `json:"fullname"`
+	Id       int    `json:"id"`
+	ImageUrl string `json:"image_url"`
+	Timezone string `json:"timezone"`
+}
+
+// GetUsers retrieves the users of a workspace.
+func (c Client) GetUsers(input GetUsersInput) ([]GetUsersOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(usersPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetUsersOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	guo := make([]GetUsersOutput, 0)
+	if err := json.Unmarshal(body, &guo); err != nil {
+		return nil, err
+	}
+
+	return guo, nil
+}
+
+// GetWorkspaceUsersInput contains the input data for GetWorkspaceUsers.
+type GetWorkspaceUsersInput struct {
+	WorkspaceId int // required
+}
+
+// GetWorkspaceUsersOutput represents the membership of a user in a workspace.
+type GetWorkspaceUsersOutput struct {
+	Active               bool     `json:"active"`
+	Admin                bool     `json:"admin"`
+	At                   string   `json:"at"`
+	CreatedAt            string   `json:"created_at"`
+	Email                string   `json:"email"`
+	GroupIds             []int    `json:"group_ids"`
+	Id                   int      `json:"id"`
+	Inactive             bool     `json:"inactive"`
+	LaborCost            *float64 `json:"labor_cost"`
+	LaborCostLastUpdated *string  `json:"labor_cost_last_updated"`
+	Name                 string   `json:"name"`
+	OrganizationAdmin    bool     `json:"organization_admin"`
+	Rate                 *float64 `json:"rate"`
+	RateLastUpdated      *string  `json:"rate_last_updated"`
+	Role                 string   `json:"role"`
+	Timezone             string   `json:"timezone"`
+	Uid                  int      `json:"uid"`
+	WorkspaceAdmin       bool     `json:"workspace_admin"`
+	WorkspaceId          int      `json:"wid"`
+}
+
+// GetWorkspaceUsers retrieves the memberships of a workspace including rates and admin flags.
+func (c Client) GetWorkspaceUsers(input GetWorkspaceUsersInput) ([]GetWorkspaceUsersOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(workspaceUsersPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetWorkspaceUsersOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	gwuo := make([]GetWorkspaceUsersOutput, 0)
+	if err := json.Unmarshal(body, &gwuo); err != nil {
+		return nil, err
+	}
+
+	return gwuo, nil
+}
+
+// PutWorkspaceUserBody represents the body of the request to update a workspace user.
+type PutWorkspaceUserBody struct {
+	Admin          *bool    `json:"admin,omitempty"`            // Whether the user is a workspace admin
+	LaborCost      *float64 `json:"labor_cost,omitempty"`       // Labor cost of the user
+	Rate           *float64 `json:"rate,omitempty"`             // Hourly rate of the user
+	RateChangeMode string   `json:"rate_change_mode,omitempty"` // Rate change mode: "start-today", "override-current" or "override-all"
+	Role           string   `json:"role,omitempty"`             // Role of the user in the workspace
+}
+
+// PutWorkspaceUserInput contains the input data for PutWorkspaceUser.
+type PutWorkspaceUserInput struct {
+	WorkspaceId     int // required
+	WorkspaceUserId int // required
+	Body            PutWorkspaceUserBody
+}
+
+// PutWorkspaceUserOutput represents the response after updating a workspace user.
+type PutWorkspaceUserOutput = GetWorkspaceUsersOutput
+
+// PutWorkspaceUser updates the rates and admin flag of a workspace user.
+func (c Client) PutWorkspaceUser(input PutWorkspaceUserInput) (PutWorkspaceUserOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutWorkspaceUserOutput{}, ErrorRequiredParameter
+	}
+	if input.WorkspaceUserId == 0 {
+		slog.Error("WorkspaceUserId is required")
+		return PutWorkspaceUserOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutWorkspaceUserOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(workspaceUserPath, input.WorkspaceId, input.WorkspaceUserId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutWorkspaceUserOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutWorkspaceUserOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutWorkspaceUserOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutWorkspaceUserOutput{}, ErrorStatusNotOK
+	}
+
+	pwuo := PutWorkspaceUserOutput{}
+	if err := json.Unmarshal(body, &pwuo); err != nil {
+		return PutWorkspaceUserOutput{}, err
+	}
+
+	return pwuo, nil
+}
+
+// DeleteWorkspaceUserInput contains the input data for DeleteWorkspaceUser.
+type DeleteWorkspaceUserInput struct {
+	WorkspaceId     int // required
+	WorkspaceUserId int // required
+}
+
+// DeleteWorkspaceUser removes a user from a workspace.
+func (c Client) DeleteWorkspaceUser(input DeleteWorkspaceUserInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.WorkspaceUserId == 0 {
+		slog.Error("WorkspaceUserId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(workspaceUserPath, input.WorkspaceId, input.WorkspaceUserId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
+
+// GetGroupsInput contains the input data for GetGroups.
+type GetGroupsInput struct {
+	WorkspaceId int // required
+}
+
+// GetGroupsOutput represents a group of a workspace.
+type GetGroupsOutput struct {
+	At          string `json:"at"`
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	UserIds     []int  `json:"user_ids"`
+	WorkspaceId int    `json:"workspace_id"`
+}
+
+// GetGroups retrieves the groups of a workspace.
+func (c Client) GetGroups(input GetGroupsInput) ([]GetGroupsOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return nil, ErrorRequiredParameter
+	}
+	toggl := c.Get(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(groupsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return []GetGroupsOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return nil, ErrorStatusNotOK
+	}
+
+	ggo := make([]GetGroupsOutput, 0)
+	if err := json.Unmarshal(body, &ggo); err != nil {
+		return nil, err
+	}
+
+	return ggo, nil
+}
+
+// PostGroupBody represents the body of the request to create a workspace group.
+type PostGroupBody struct {
+	Name    string `json:"name"`               // Group name, required
+	UserIds []int  `json:"user_ids,omitempty"` // IDs of the users in the group
+}
+
+// PostGroupInput contains the input data for PostGroup.
+type PostGroupInput struct {
+	WorkspaceId int // required
+	Body        PostGroupBody
+}
+
+// PostGroupOutput represents the response after creating a workspace group.
+type PostGroupOutput = GetGroupsOutput
+
+// PostGroup creates a new group in a workspace.
+func (c Client) PostGroup(input PostGroupInput) (PostGroupOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PostGroupOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PostGroupOutput{}, err
+	}
+	toggl := c.Post(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(groupsPath, input.WorkspaceId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PostGroupOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostGroupOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PostGroupOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PostGroupOutput{}, ErrorStatusNotOK
+	}
+
+	pgo := PostGroupOutput{}
+	if err := json.Unmarshal(body, &pgo); err != nil {
+		return PostGroupOutput{}, err
+	}
+
+	return pgo, nil
+}
+
+// PutGroupBody represents the body of the request to update a workspace group.
+type PutGroupBody = PostGroupBody
+
+// PutGroupInput contains the input data for PutGroup.
+type PutGroupInput struct {
+	WorkspaceId int // required
+	GroupId     int // required
+	Body        PutGroupBody
+}
+
+// PutGroupOutput represents the response after updating a workspace group.
+type PutGroupOutput = GetGroupsOutput
+
+// PutGroup updates an existing workspace group, replacing its members.
+func (c Client) PutGroup(input PutGroupInput) (PutGroupOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PutGroupOutput{}, ErrorRequiredParameter
+	}
+	if input.GroupId == 0 {
+		slog.Error("GroupId is required")
+		return PutGroupOutput{}, ErrorRequiredParameter
+	}
+	j, err := json.Marshal(input.Body)
+	if err != nil {
+		return PutGroupOutput{}, err
+	}
+	toggl := c.Put(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(groupPath, input.WorkspaceId, input.GroupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PutGroupOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PutGroupOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PutGroupOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PutGroupOutput{}, ErrorStatusNotOK
+	}
+
+	pgo := PutGroupOutput{}
+	if err := json.Unmarshal(body, &pgo); err != nil {
+		return PutGroupOutput{}, err
+	}
+
+	return pgo, nil
+}
+
+// PatchGroupMembersInput contains the input data for PatchGroupMembers.
+type PatchGroupMembersInput struct {
+	WorkspaceId int   // required
+	GroupId     int   // required
+	Add         []int // IDs of the users to add to the group
+	Remove      []int // IDs of the users to remove from the group
+}
+
+// patchOperation represents a single JSON patch operation.
+type patchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// PatchGroupMembersOutput represents the response after changing the members of a workspace group.
+type PatchGroupMembersOutput = GetGroupsOutput
+
+// PatchGroupMembers adds and removes users of a workspace group in a single request.
+func (c Client) PatchGroupMembers(input PatchGroupMembersInput) (PatchGroupMembersOutput, error) {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return PatchGroupMembersOutput{}, ErrorRequiredParameter
+	}
+	if input.GroupId == 0 {
+		slog.Error("GroupId is required")
+		return PatchGroupMembersOutput{}, ErrorRequiredParameter
+	}
+	if len(input.Add) == 0 && len(input.Remove) == 0 {
+		slog.Error("Add or Remove is required")
+		return PatchGroupMembersOutput{}, ErrorRequiredParameter
+	}
+	ops := make([]patchOperation, 0, 2)
+	if len(input.Add) > 0 {
+		ops = append(ops, patchOperation{Op: "add", Path: "/user_ids", Value: input.Add})
+	}
+	if len(input.Remove) > 0 {
+		ops = append(ops, patchOperation{Op: "remove", Path: "/user_ids", Value: input.Remove})
+	}
+	j, err := json.Marshal(ops)
+	if err != nil {
+		return PatchGroupMembersOutput{}, err
+	}
+	toggl := c.Patch(url.URL{}, j)
+	toggl.URL.Path = fmt.Sprintf(groupPath, input.WorkspaceId, input.GroupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return PatchGroupMembersOutput{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PatchGroupMembersOutput{}, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return PatchGroupMembersOutput{}, nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return PatchGroupMembersOutput{}, ErrorStatusNotOK
+	}
+
+	pgo := PatchGroupMembersOutput{}
+	if err := json.Unmarshal(body, &pgo); err != nil {
+		return PatchGroupMembersOutput{}, err
+	}
+
+	return pgo, nil
+}
+
+// DeleteGroupInput contains the input data for DeleteGroup.
+type DeleteGroupInput struct {
+	WorkspaceId int // required
+	GroupId     int // required
+}
+
+// DeleteGroup deletes a group from a workspace.
+func (c Client) DeleteGroup(input DeleteGroupInput) error {
+	if input.WorkspaceId == 0 {
+		slog.Error("WorkspaceId is required")
+		return ErrorRequiredParameter
+	}
+	if input.GroupId == 0 {
+		slog.Error("GroupId is required")
+		return ErrorRequiredParameter
+	}
+	toggl := c.Delete(url.URL{})
+	toggl.URL.Path = fmt.Sprintf(groupPath, input.WorkspaceId, input.GroupId)
+
+	resp, err := c.HttpClient.Do(&toggl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil
+	default:
+		slog.Error(fmt.Sprintf("Error response status code: %v, message: %v", resp.Status, string(body)))
+		return ErrorStatusNotOK
+	}
+
+	return nil
+}
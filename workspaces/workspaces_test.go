@@ -0,0 +1,348 @@
+package workspaces_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dev-shimada/toggl-go/workspaces"
+	"github.com/google/go-cmp/cmp"
+)
+
+type MockHttpClient struct {
+	DoFunc func(*http.Request) (*http.Response, error)
+}
+
+func (m MockHttpClient) Do(r *http.Request) (*http.Response, error) {
+	return m.DoFunc(r)
+}
+
+func fakeClient(res *http.Response) workspaces.Client {
+	return workspaces.Client{
+		HttpClient: MockHttpClient{
+			DoFunc: func(r *http.Request) (*http.Response, error) {
+				return res, nil
+			},
+		},
+	}
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	testFile, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(testFile)
+}
+
+func checkError(t *testing.T, wantErr, err error) {
+	t.Helper()
+	if wantErr != nil && err == nil {
+		t.Errorf("Expected error, got nil")
+	} else if wantErr != nil && err != nil {
+		if err.Error() != wantErr.Error() {
+			t.Errorf("Expected error %v, got %v", wantErr, err)
+		}
+	} else if wantErr == nil && err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func checkJson(t *testing.T, wantJson []byte, got any) {
+	t.Helper()
+	jgot, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jgot = bytes.TrimSpace(jgot)
+	if !cmp.Equal(wantJson, jgot) {
+		t.Errorf("diff: %v", cmp.Diff(wantJson, jgot))
+	}
+}
+
+func TestGetUsers(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/users.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.GetUsersInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.GetUsersInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, workspaces.GetUsersInput{}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.GetUsersInput{WorkspaceId: workspaceId}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetUsers(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestGetWorkspaceUsers(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/workspace_users.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.GetWorkspaceUsersInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.GetWorkspaceUsersInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, workspaces.GetWorkspaceUsersInput{}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.GetWorkspaceUsersInput{WorkspaceId: workspaceId}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetWorkspaceUsers(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutWorkspaceUser(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/workspace_user.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PutWorkspaceUserOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	workspaceUserId := 1234567890
+	rate := 50.0
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PutWorkspaceUserInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PutWorkspaceUserInput{WorkspaceId: workspaceId, WorkspaceUserId: workspaceUserId, Body: workspaces.PutWorkspaceUserBody{Rate: &rate}}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.PutWorkspaceUserInput{WorkspaceUserId: workspaceUserId, Body: workspaces.PutWorkspaceUserBody{Rate: &rate}}, errorWant, workspaces.ErrorRequiredParameter},
+		{"WorkspaceUserId parameter error", successClient, workspaces.PutWorkspaceUserInput{WorkspaceId: workspaceId, Body: workspaces.PutWorkspaceUserBody{Rate: &rate}}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PutWorkspaceUserInput{WorkspaceId: workspaceId, WorkspaceUserId: workspaceUserId, Body: workspaces.PutWorkspaceUserBody{Rate: &rate}}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutWorkspaceUser(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteWorkspaceUser(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	workspaceUserId := 1234567890
+	test := []struct {
+		name    string
+		client  workspaces.Client
+		arg     workspaces.DeleteWorkspaceUserInput
+		wantErr error
+	}{
+		{"success", successClient, workspaces.DeleteWorkspaceUserInput{WorkspaceId: workspaceId, WorkspaceUserId: workspaceUserId}, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.DeleteWorkspaceUserInput{WorkspaceUserId: workspaceUserId}, workspaces.ErrorRequiredParameter},
+		{"WorkspaceUserId parameter error", successClient, workspaces.DeleteWorkspaceUserInput{WorkspaceId: workspaceId}, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.DeleteWorkspaceUserInput{WorkspaceId: workspaceId, WorkspaceUserId: workspaceUserId}, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteWorkspaceUser(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetGroups(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/groups.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(nil, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.GetGroupsInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.GetGroupsInput{WorkspaceId: workspaceId}, testFile, nil},
+		{"parameter error", successClient, workspaces.GetGroupsInput{}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.GetGroupsInput{WorkspaceId: workspaceId}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetGroups(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPostGroup(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/group.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PostGroupOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PostGroupInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PostGroupInput{WorkspaceId: workspaceId, Body: workspaces.PostGroupBody{Name: "name"}}, testFile, nil},
+		{"parameter error", successClient, workspaces.PostGroupInput{Body: workspaces.PostGroupBody{Name: "name"}}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PostGroupInput{WorkspaceId: workspaceId, Body: workspaces.PostGroupBody{Name: "name"}}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PostGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPutGroup(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/group.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PutGroupOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	groupId := 1234567890
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PutGroupInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PutGroupInput{WorkspaceId: workspaceId, GroupId: groupId, Body: workspaces.PutGroupBody{Name: "name"}}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.PutGroupInput{GroupId: groupId, Body: workspaces.PutGroupBody{Name: "name"}}, errorWant, workspaces.ErrorRequiredParameter},
+		{"GroupId parameter error", successClient, workspaces.PutGroupInput{WorkspaceId: workspaceId, Body: workspaces.PutGroupBody{Name: "name"}}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PutGroupInput{WorkspaceId: workspaceId, GroupId: groupId, Body: workspaces.PutGroupBody{Name: "name"}}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PutGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestPatchGroupMembers(t *testing.T) {
+	testFile := readTestFile(t, "testdata/workspaces/group.json")
+	okBody := io.NopCloser(bytes.NewBuffer(testFile))
+
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: okBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+	errorWant, err := json.MarshalIndent(workspaces.PatchGroupMembersOutput{}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaceId := 123456789
+	groupId := 1234567890
+	add := []int{1, 2}
+	test := []struct {
+		name     string
+		client   workspaces.Client
+		arg      workspaces.PatchGroupMembersInput
+		wantJson []byte
+		wantErr  error
+	}{
+		{"success", successClient, workspaces.PatchGroupMembersInput{WorkspaceId: workspaceId, GroupId: groupId, Add: add}, testFile, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.PatchGroupMembersInput{GroupId: groupId, Add: add}, errorWant, workspaces.ErrorRequiredParameter},
+		{"GroupId parameter error", successClient, workspaces.PatchGroupMembersInput{WorkspaceId: workspaceId, Add: add}, errorWant, workspaces.ErrorRequiredParameter},
+		{"Add parameter error", successClient, workspaces.PatchGroupMembersInput{WorkspaceId: workspaceId, GroupId: groupId}, errorWant, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.PatchGroupMembersInput{WorkspaceId: workspaceId, GroupId: groupId, Add: add}, errorWant, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.PatchGroupMembers(tt.arg)
+			checkError(t, tt.wantErr, err)
+			checkJson(t, tt.wantJson, got)
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	successClient := fakeClient(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	errorClient := fakeClient(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody})
+
+	workspaceId := 123456789
+	groupId := 1234567890
+	test := []struct {
+		name    string
+		client  workspaces.Client
+		arg     workspaces.DeleteGroupInput
+		wantErr error
+	}{
+		{"success", successClient, workspaces.DeleteGroupInput{WorkspaceId: workspaceId, GroupId: groupId}, nil},
+		{"WorkspaceId parameter error", successClient, workspaces.DeleteGroupInput{GroupId: groupId}, workspaces.ErrorRequiredParameter},
+		{"GroupId parameter error", successClient, workspaces.DeleteGroupInput{WorkspaceId: workspaceId}, workspaces.ErrorRequiredParameter},
+		{"http error", errorClient, workspaces.DeleteGroupInput{WorkspaceId: workspaceId, GroupId: groupId}, workspaces.ErrorStatusNotOK},
+	}
+	for _, tt := range test {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.DeleteGroup(tt.arg)
+			checkError(t, tt.wantErr, err)
+		})
+	}
+}